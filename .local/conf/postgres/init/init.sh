@@ -16,6 +16,173 @@ psql -v ON_ERROR_STOP=1 --username postgres --dbname postgres <<-EOSQL
 
   CREATE INDEX stocks_code_index ON stocks (code);
 
+  CREATE TABLE "quote"
+  (
+      "id"       BIGSERIAL PRIMARY KEY,
+      "stock_id" BIGINT           NOT NULL,
+      "bid"      DOUBLE PRECISION NOT NULL,
+      "ask"      DOUBLE PRECISION NOT NULL,
+      "last"     DOUBLE PRECISION NOT NULL,
+      "volume"   BIGINT           NOT NULL,
+      "time"     TIMESTAMPTZ      NOT NULL,
+      FOREIGN KEY (stock_id) REFERENCES stocks (id)
+          ON UPDATE CASCADE ON DELETE CASCADE
+  );
+
+  CREATE INDEX quote_stock_id_time_index ON quote (stock_id, time);
+
+  CREATE TABLE "candle"
+  (
+      "stock_id" BIGINT           NOT NULL,
+      "period"   VARCHAR(3)       NOT NULL,
+      "start"    TIMESTAMPTZ      NOT NULL,
+      "open"     DOUBLE PRECISION NOT NULL,
+      "high"     DOUBLE PRECISION NOT NULL,
+      "low"      DOUBLE PRECISION NOT NULL,
+      "close"    DOUBLE PRECISION NOT NULL,
+      "volume"   BIGINT           NOT NULL,
+      PRIMARY KEY ("stock_id", "period", "start"),
+      FOREIGN KEY (stock_id) REFERENCES stocks (id)
+          ON UPDATE CASCADE ON DELETE CASCADE
+  );
+
+  CREATE TABLE "corporate_action"
+  (
+      "id"            BIGSERIAL PRIMARY KEY,
+      "stock_id"      BIGINT      NOT NULL,
+      "type"          VARCHAR(16) NOT NULL,
+      "old_shares"    BIGINT      NOT NULL DEFAULT 0,
+      "new_shares"    BIGINT      NOT NULL DEFAULT 0,
+      "shares"        BIGINT      NOT NULL DEFAULT 0,
+      "effective_at"  TIMESTAMPTZ NOT NULL,
+      "applied_at"    TIMESTAMPTZ,
+      "shares_before" BIGINT,
+      "shares_after"  BIGINT,
+      "created_at"    TIMESTAMPTZ NOT NULL DEFAULT now(),
+      FOREIGN KEY (stock_id) REFERENCES stocks (id)
+          ON UPDATE CASCADE ON DELETE CASCADE
+  );
+
+  CREATE INDEX corporate_action_stock_id_index ON corporate_action (stock_id, effective_at);
+  CREATE INDEX corporate_action_pending_index ON corporate_action (effective_at) WHERE applied_at IS NULL;
+
+  CREATE TABLE "alert"
+  (
+      "id"                BIGSERIAL PRIMARY KEY,
+      "user_id"           VARCHAR(255)     NOT NULL,
+      "stock_id"          BIGINT           NOT NULL,
+      "type"              VARCHAR(16)      NOT NULL,
+      "threshold"         DOUBLE PRECISION NOT NULL,
+      "window_seconds"    BIGINT           NOT NULL DEFAULT 0,
+      "webhook_url"       TEXT             NOT NULL DEFAULT '',
+      "snoozed_until"     TIMESTAMPTZ,
+      "last_triggered_at" TIMESTAMPTZ,
+      "created_at"        TIMESTAMPTZ      NOT NULL DEFAULT now(),
+      FOREIGN KEY (stock_id) REFERENCES stocks (id)
+          ON UPDATE CASCADE ON DELETE CASCADE
+  );
+
+  CREATE INDEX alert_user_id_index ON alert (user_id);
+
+  CREATE TABLE "watchlist"
+  (
+      "id"         BIGSERIAL PRIMARY KEY,
+      "user_id"    VARCHAR(255) NOT NULL,
+      "name"       VARCHAR(255) NOT NULL,
+      "created_at" TIMESTAMPTZ  NOT NULL DEFAULT now(),
+      UNIQUE ("user_id", "name")
+  );
+
+  CREATE TABLE "watchlist_item"
+  (
+      "watchlist_id" BIGINT  NOT NULL,
+      "stock_id"     BIGINT  NOT NULL,
+      "position"     INTEGER NOT NULL,
+      PRIMARY KEY ("watchlist_id", "stock_id"),
+      FOREIGN KEY (watchlist_id) REFERENCES watchlist (id)
+          ON UPDATE CASCADE ON DELETE CASCADE,
+      FOREIGN KEY (stock_id) REFERENCES stocks (id)
+          ON UPDATE CASCADE ON DELETE CASCADE
+  );
+
+  CREATE TABLE "orders"
+  (
+      "id"              BIGSERIAL PRIMARY KEY,
+      "account_id"      VARCHAR(255)     NOT NULL,
+      "stock_id"        BIGINT           NOT NULL,
+      "side"            VARCHAR(4)       NOT NULL,
+      "type"            VARCHAR(6)       NOT NULL,
+      "price"           NUMERIC(20, 2)   NOT NULL DEFAULT 0,
+      "quantity"        BIGINT           NOT NULL,
+      "filled_quantity" BIGINT           NOT NULL DEFAULT 0,
+      "status"          VARCHAR(16)      NOT NULL,
+      "created_at"      TIMESTAMPTZ      NOT NULL DEFAULT now(),
+      "updated_at"      TIMESTAMPTZ      NOT NULL DEFAULT now(),
+      "priority_at"     TIMESTAMPTZ      NOT NULL DEFAULT now(),
+      FOREIGN KEY (stock_id) REFERENCES stocks (id)
+          ON UPDATE CASCADE ON DELETE CASCADE
+  );
+
+  CREATE INDEX orders_status_index ON orders (status);
+  CREATE INDEX orders_account_id_index ON orders (account_id);
+
+  CREATE TABLE "trade"
+  (
+      "id"            BIGSERIAL PRIMARY KEY,
+      "stock_id"      BIGINT           NOT NULL,
+      "price"         NUMERIC(20, 2)   NOT NULL,
+      "quantity"      BIGINT           NOT NULL,
+      "buy_order_id"  BIGINT           NOT NULL,
+      "sell_order_id" BIGINT           NOT NULL,
+      "time"          TIMESTAMPTZ      NOT NULL,
+      FOREIGN KEY (stock_id) REFERENCES stocks (id)
+          ON UPDATE CASCADE ON DELETE CASCADE,
+      FOREIGN KEY (buy_order_id) REFERENCES orders (id),
+      FOREIGN KEY (sell_order_id) REFERENCES orders (id)
+  );
+
+  CREATE INDEX trade_stock_id_time_index ON trade (stock_id, time);
+
+  CREATE TABLE "account"
+  (
+      "id"         VARCHAR(255) PRIMARY KEY,
+      "cash"       NUMERIC(20, 2) NOT NULL DEFAULT 0,
+      "created_at" TIMESTAMPTZ    NOT NULL DEFAULT now()
+  );
+
+  CREATE TABLE "journal"
+  (
+      "id"         BIGSERIAL PRIMARY KEY,
+      "kind"       VARCHAR(16) NOT NULL,
+      "reference"  BIGINT      NOT NULL DEFAULT 0,
+      "memo"       TEXT        NOT NULL DEFAULT '',
+      "created_at" TIMESTAMPTZ NOT NULL DEFAULT now()
+  );
+
+  CREATE TABLE "ledger_entry"
+  (
+      "id"         BIGSERIAL PRIMARY KEY,
+      "journal_id" BIGINT         NOT NULL,
+      "account_id" VARCHAR(255)   NOT NULL,
+      "amount"     NUMERIC(20, 2) NOT NULL,
+      FOREIGN KEY (journal_id) REFERENCES journal (id)
+  );
+
+  CREATE INDEX ledger_entry_account_id_index ON ledger_entry (account_id);
+
+  CREATE TABLE "holding"
+  (
+      "account_id" VARCHAR(255)   NOT NULL,
+      "stock_id"   BIGINT         NOT NULL,
+      "quantity"   BIGINT         NOT NULL DEFAULT 0,
+      "cost"       NUMERIC(20, 2) NOT NULL DEFAULT 0,
+      "realized"   NUMERIC(20, 2) NOT NULL DEFAULT 0,
+      PRIMARY KEY ("account_id", "stock_id"),
+      FOREIGN KEY (account_id) REFERENCES account (id),
+      FOREIGN KEY (stock_id) REFERENCES stocks (id)
+          ON UPDATE CASCADE ON DELETE CASCADE
+  );
+
   INSERT INTO stocks (code, name, total_stock_count) VALUES ('IMP', 'imp', 387000000);
   INSERT INTO stocks (code, name, total_stock_count) VALUES ('POL', 'polecat', 527000000);
   INSERT INTO stocks (code, name, total_stock_count) VALUES ('SAL', 'salmon', 46000000);
@@ -29,19 +196,113 @@ psql -v ON_ERROR_STOP=1 --username postgres --dbname postgres <<-EOSQL
 
   CREATE TABLE "subject"
   (
-      "id"      BIGSERIAL PRIMARY KEY,
-      "title"   VARCHAR(100) unique,
-      "enabled" BOOL DEFAULT true
+      "id"             BIGSERIAL PRIMARY KEY,
+      "title"          VARCHAR(100) unique,
+      "enabled"        BOOL DEFAULT true,
+      "opens_at"       TIMESTAMPTZ,
+      "closes_at"      TIMESTAMPTZ,
+      "schedule_state" VARCHAR(10) NOT NULL DEFAULT '',
+      "closed_at"      TIMESTAMPTZ,
+      "created_at"     TIMESTAMPTZ NOT NULL DEFAULT now(),
+      "updated_at"     TIMESTAMPTZ NOT NULL DEFAULT now(),
+      "deleted_at"     TIMESTAMPTZ
   );
 
+  CREATE INDEX subject_deleted_at_index ON subject (deleted_at) WHERE deleted_at IS NOT NULL;
+
   CREATE TABLE "question"
   (
       "id"         BIGSERIAL PRIMARY KEY,
       "question"   TEXT      NOT NULL,
       "subject_id" BIGSERIAL NOT NULL,
       "likes"      BIGINT DEFAULT 0,
+      "author_id"  VARCHAR(255) NOT NULL DEFAULT '',
+      "created_at" TIMESTAMPTZ  NOT NULL DEFAULT now(),
+      "updated_at" TIMESTAMPTZ  NOT NULL DEFAULT now(),
+      "answered"   BOOL         NOT NULL DEFAULT false,
+      "search"     TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', question)) STORED,
+      "deleted_at" TIMESTAMPTZ,
+      FOREIGN KEY (subject_id) REFERENCES subject (id)
+          ON UPDATE CASCADE ON DELETE RESTRICT
+  );
+
+  CREATE INDEX question_search_index ON question USING GIN (search);
+  CREATE INDEX question_subject_id_created_at_index ON question (subject_id, created_at);
+  CREATE INDEX question_deleted_at_index ON question (deleted_at) WHERE deleted_at IS NOT NULL;
+
+  CREATE TABLE "tag"
+  (
+      "id"         BIGSERIAL PRIMARY KEY,
+      "subject_id" BIGINT      NOT NULL,
+      "name"       VARCHAR(50) NOT NULL,
+      UNIQUE ("subject_id", "name"),
       FOREIGN KEY (subject_id) REFERENCES subject (id)
           ON UPDATE CASCADE ON DELETE CASCADE
   );
 
+  CREATE TABLE "question_tag"
+  (
+      "question_id" BIGINT NOT NULL,
+      "tag_id"      BIGINT NOT NULL,
+      PRIMARY KEY ("question_id", "tag_id"),
+      FOREIGN KEY (question_id) REFERENCES question (id)
+          ON UPDATE CASCADE ON DELETE CASCADE,
+      FOREIGN KEY (tag_id) REFERENCES tag (id)
+          ON UPDATE CASCADE ON DELETE CASCADE
+  );
+
+  CREATE INDEX question_tag_tag_id_index ON question_tag (tag_id);
+
+  CREATE TABLE "subject_ranking"
+  (
+      "subject_id"  BIGINT  NOT NULL,
+      "rank"        INTEGER NOT NULL,
+      "question_id" BIGINT  NOT NULL,
+      "question"    TEXT    NOT NULL,
+      "likes"       BIGINT  NOT NULL,
+      PRIMARY KEY ("subject_id", "question_id"),
+      FOREIGN KEY (subject_id) REFERENCES subject (id)
+          ON UPDATE CASCADE ON DELETE CASCADE
+  );
+
+  CREATE TABLE "question_edit"
+  (
+      "id"          BIGSERIAL PRIMARY KEY,
+      "question_id" BIGINT       NOT NULL,
+      "action"      VARCHAR(10)  NOT NULL,
+      "question"    TEXT         NOT NULL,
+      "editor_id"   VARCHAR(255) NOT NULL DEFAULT '',
+      "edited_at"   TIMESTAMPTZ  NOT NULL DEFAULT now()
+  );
+
+  CREATE INDEX question_edit_question_id_index ON question_edit (question_id);
+
+  CREATE TABLE "audit_log"
+  (
+      "id"          BIGSERIAL PRIMARY KEY,
+      "actor_id"    VARCHAR(255) NOT NULL DEFAULT '',
+      "method"      VARCHAR(100) NOT NULL,
+      "target_type" VARCHAR(20)  NOT NULL,
+      "target_id"   BIGINT       NOT NULL,
+      "before"      JSONB,
+      "after"       JSONB,
+      "request_id"  VARCHAR(128) NOT NULL DEFAULT '',
+      "created_at"  TIMESTAMPTZ  NOT NULL DEFAULT now()
+  );
+
+  CREATE INDEX audit_log_target_index ON audit_log (target_type, target_id);
+  CREATE INDEX audit_log_actor_id_index ON audit_log (actor_id);
+  CREATE INDEX audit_log_request_id_index ON audit_log (request_id);
+  CREATE INDEX audit_log_created_at_index ON audit_log (created_at);
+
+  CREATE FUNCTION audit_log_append_only() RETURNS trigger AS \$\$
+  BEGIN
+      RAISE EXCEPTION 'audit_log is append-only';
+  END;
+  \$\$ LANGUAGE plpgsql;
+
+  CREATE TRIGGER audit_log_append_only
+      BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_log
+      FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
+
 EOSQL
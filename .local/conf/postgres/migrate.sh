@@ -0,0 +1,24 @@
+#!/bin/bash
+#
+# Brings an existing database up to the schema of init/init.sh, which only
+# runs when the docker volume is created. Every script in migrations/ can be
+# re-run, so this applies all of them in order, each in its own transaction.
+#
+# Takes the same PG_* variables as the server. For the RDS instance, open
+# the tunnel printed by 'terraform output postgres_session' first, then
+#
+#   PG_SSLMODE=require PG_PASSWORD=$(terraform output -raw postgres_password) ./migrate.sh
+#
+set -e
+
+export PGHOST="${PG_HOST:-localhost}"
+export PGPORT="${PG_PORT:-5432}"
+export PGUSER="${PG_USER:-postgres}"
+export PGPASSWORD="${PG_PASSWORD:-postgrespw}"
+export PGDATABASE="${PG_DATABASE:-postgres}"
+export PGSSLMODE="${PG_SSLMODE:-disable}"
+
+for f in "$(dirname "$0")"/migrations/*.sql; do
+  echo "applying $(basename "$f")"
+  psql -v ON_ERROR_STOP=1 --single-transaction --quiet -f "$f"
+done
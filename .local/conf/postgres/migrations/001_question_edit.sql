@@ -0,0 +1,16 @@
+-- question ownership and edit history
+
+ALTER TABLE question ADD COLUMN IF NOT EXISTS "author_id"  VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE question ADD COLUMN IF NOT EXISTS "created_at" TIMESTAMPTZ  NOT NULL DEFAULT now();
+
+CREATE TABLE IF NOT EXISTS "question_edit"
+(
+    "id"          BIGSERIAL PRIMARY KEY,
+    "question_id" BIGINT       NOT NULL,
+    "action"      VARCHAR(10)  NOT NULL,
+    "question"    TEXT         NOT NULL,
+    "editor_id"   VARCHAR(255) NOT NULL DEFAULT '',
+    "edited_at"   TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS question_edit_question_id_index ON question_edit (question_id);
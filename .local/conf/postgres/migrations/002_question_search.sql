@@ -0,0 +1,7 @@
+-- full-text search and the answered flag of questions
+
+ALTER TABLE question ADD COLUMN IF NOT EXISTS "answered" BOOL NOT NULL DEFAULT false;
+ALTER TABLE question ADD COLUMN IF NOT EXISTS "search"   TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', question)) STORED;
+
+CREATE INDEX IF NOT EXISTS question_search_index ON question USING GIN (search);
+CREATE INDEX IF NOT EXISTS question_subject_id_created_at_index ON question (subject_id, created_at);
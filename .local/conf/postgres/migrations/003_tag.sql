@@ -0,0 +1,24 @@
+-- per-subject tags of questions
+
+CREATE TABLE IF NOT EXISTS "tag"
+(
+    "id"         BIGSERIAL PRIMARY KEY,
+    "subject_id" BIGINT      NOT NULL,
+    "name"       VARCHAR(50) NOT NULL,
+    UNIQUE ("subject_id", "name"),
+    FOREIGN KEY (subject_id) REFERENCES subject (id)
+        ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS "question_tag"
+(
+    "question_id" BIGINT NOT NULL,
+    "tag_id"      BIGINT NOT NULL,
+    PRIMARY KEY ("question_id", "tag_id"),
+    FOREIGN KEY (question_id) REFERENCES question (id)
+        ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tag (id)
+        ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS question_tag_tag_id_index ON question_tag (tag_id);
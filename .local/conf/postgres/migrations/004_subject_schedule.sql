@@ -0,0 +1,5 @@
+-- scheduled open/close windows of subjects
+
+ALTER TABLE subject ADD COLUMN IF NOT EXISTS "opens_at"       TIMESTAMPTZ;
+ALTER TABLE subject ADD COLUMN IF NOT EXISTS "closes_at"      TIMESTAMPTZ;
+ALTER TABLE subject ADD COLUMN IF NOT EXISTS "schedule_state" VARCHAR(10) NOT NULL DEFAULT '';
@@ -0,0 +1,15 @@
+-- closed subjects and their frozen ranking
+
+ALTER TABLE subject ADD COLUMN IF NOT EXISTS "closed_at" TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS "subject_ranking"
+(
+    "subject_id"  BIGINT  NOT NULL,
+    "rank"        INTEGER NOT NULL,
+    "question_id" BIGINT  NOT NULL,
+    "question"    TEXT    NOT NULL,
+    "likes"       BIGINT  NOT NULL,
+    PRIMARY KEY ("subject_id", "question_id"),
+    FOREIGN KEY (subject_id) REFERENCES subject (id)
+        ON UPDATE CASCADE ON DELETE CASCADE
+);
@@ -0,0 +1,16 @@
+-- quote history of the price feed
+
+CREATE TABLE IF NOT EXISTS "quote"
+(
+    "id"       BIGSERIAL PRIMARY KEY,
+    "stock_id" BIGINT           NOT NULL,
+    "bid"      DOUBLE PRECISION NOT NULL,
+    "ask"      DOUBLE PRECISION NOT NULL,
+    "last"     DOUBLE PRECISION NOT NULL,
+    "volume"   BIGINT           NOT NULL,
+    "time"     TIMESTAMPTZ      NOT NULL,
+    FOREIGN KEY (stock_id) REFERENCES stocks (id)
+        ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS quote_stock_id_time_index ON quote (stock_id, time);
@@ -0,0 +1,39 @@
+-- orders and trades of the matching engine
+
+CREATE TABLE IF NOT EXISTS "orders"
+(
+    "id"              BIGSERIAL PRIMARY KEY,
+    "account_id"      VARCHAR(255)     NOT NULL,
+    "stock_id"        BIGINT           NOT NULL,
+    "side"            VARCHAR(4)       NOT NULL,
+    "type"            VARCHAR(6)       NOT NULL,
+    "price"           DOUBLE PRECISION NOT NULL DEFAULT 0,
+    "quantity"        BIGINT           NOT NULL,
+    "filled_quantity" BIGINT           NOT NULL DEFAULT 0,
+    "status"          VARCHAR(16)      NOT NULL,
+    "created_at"      TIMESTAMPTZ      NOT NULL DEFAULT now(),
+    "updated_at"      TIMESTAMPTZ      NOT NULL DEFAULT now(),
+    "priority_at"     TIMESTAMPTZ      NOT NULL DEFAULT now(),
+    FOREIGN KEY (stock_id) REFERENCES stocks (id)
+        ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS orders_status_index ON orders (status);
+CREATE INDEX IF NOT EXISTS orders_account_id_index ON orders (account_id);
+
+CREATE TABLE IF NOT EXISTS "trade"
+(
+    "id"            BIGSERIAL PRIMARY KEY,
+    "stock_id"      BIGINT           NOT NULL,
+    "price"         DOUBLE PRECISION NOT NULL,
+    "quantity"      BIGINT           NOT NULL,
+    "buy_order_id"  BIGINT           NOT NULL,
+    "sell_order_id" BIGINT           NOT NULL,
+    "time"          TIMESTAMPTZ      NOT NULL,
+    FOREIGN KEY (stock_id) REFERENCES stocks (id)
+        ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (buy_order_id) REFERENCES orders (id),
+    FOREIGN KEY (sell_order_id) REFERENCES orders (id)
+);
+
+CREATE INDEX IF NOT EXISTS trade_stock_id_time_index ON trade (stock_id, time);
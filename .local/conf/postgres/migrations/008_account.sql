@@ -0,0 +1,41 @@
+-- cash ledger and positions of trading accounts
+
+CREATE TABLE IF NOT EXISTS "account"
+(
+    "id"         VARCHAR(255) PRIMARY KEY,
+    "cash"       NUMERIC(20, 2) NOT NULL DEFAULT 0,
+    "created_at" TIMESTAMPTZ    NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS "journal"
+(
+    "id"         BIGSERIAL PRIMARY KEY,
+    "kind"       VARCHAR(16) NOT NULL,
+    "reference"  BIGINT      NOT NULL DEFAULT 0,
+    "memo"       TEXT        NOT NULL DEFAULT '',
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS "ledger_entry"
+(
+    "id"         BIGSERIAL PRIMARY KEY,
+    "journal_id" BIGINT         NOT NULL,
+    "account_id" VARCHAR(255)   NOT NULL,
+    "amount"     NUMERIC(20, 2) NOT NULL,
+    FOREIGN KEY (journal_id) REFERENCES journal (id)
+);
+
+CREATE INDEX IF NOT EXISTS ledger_entry_account_id_index ON ledger_entry (account_id);
+
+CREATE TABLE IF NOT EXISTS "holding"
+(
+    "account_id" VARCHAR(255)   NOT NULL,
+    "stock_id"   BIGINT         NOT NULL,
+    "quantity"   BIGINT         NOT NULL DEFAULT 0,
+    "cost"       NUMERIC(20, 2) NOT NULL DEFAULT 0,
+    "realized"   NUMERIC(20, 2) NOT NULL DEFAULT 0,
+    PRIMARY KEY ("account_id", "stock_id"),
+    FOREIGN KEY (account_id) REFERENCES account (id),
+    FOREIGN KEY (stock_id) REFERENCES stocks (id)
+        ON UPDATE CASCADE ON DELETE CASCADE
+);
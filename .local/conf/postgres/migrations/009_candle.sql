@@ -0,0 +1,16 @@
+-- OHLCV candles
+
+CREATE TABLE IF NOT EXISTS "candle"
+(
+    "stock_id" BIGINT           NOT NULL,
+    "period"   VARCHAR(3)       NOT NULL,
+    "start"    TIMESTAMPTZ      NOT NULL,
+    "open"     DOUBLE PRECISION NOT NULL,
+    "high"     DOUBLE PRECISION NOT NULL,
+    "low"      DOUBLE PRECISION NOT NULL,
+    "close"    DOUBLE PRECISION NOT NULL,
+    "volume"   BIGINT           NOT NULL,
+    PRIMARY KEY ("stock_id", "period", "start"),
+    FOREIGN KEY (stock_id) REFERENCES stocks (id)
+        ON UPDATE CASCADE ON DELETE CASCADE
+);
@@ -0,0 +1,21 @@
+-- corporate actions with share count history
+
+CREATE TABLE IF NOT EXISTS "corporate_action"
+(
+    "id"            BIGSERIAL PRIMARY KEY,
+    "stock_id"      BIGINT      NOT NULL,
+    "type"          VARCHAR(16) NOT NULL,
+    "old_shares"    BIGINT      NOT NULL DEFAULT 0,
+    "new_shares"    BIGINT      NOT NULL DEFAULT 0,
+    "shares"        BIGINT      NOT NULL DEFAULT 0,
+    "effective_at"  TIMESTAMPTZ NOT NULL,
+    "applied_at"    TIMESTAMPTZ,
+    "shares_before" BIGINT,
+    "shares_after"  BIGINT,
+    "created_at"    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (stock_id) REFERENCES stocks (id)
+        ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS corporate_action_stock_id_index ON corporate_action (stock_id, effective_at);
+CREATE INDEX IF NOT EXISTS corporate_action_pending_index ON corporate_action (effective_at) WHERE applied_at IS NULL;
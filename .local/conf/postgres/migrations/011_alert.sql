@@ -0,0 +1,19 @@
+-- price alerts
+
+CREATE TABLE IF NOT EXISTS "alert"
+(
+    "id"                BIGSERIAL PRIMARY KEY,
+    "user_id"           VARCHAR(255)     NOT NULL,
+    "stock_id"          BIGINT           NOT NULL,
+    "type"              VARCHAR(16)      NOT NULL,
+    "threshold"         DOUBLE PRECISION NOT NULL,
+    "window_seconds"    BIGINT           NOT NULL DEFAULT 0,
+    "webhook_url"       TEXT             NOT NULL DEFAULT '',
+    "snoozed_until"     TIMESTAMPTZ,
+    "last_triggered_at" TIMESTAMPTZ,
+    "created_at"        TIMESTAMPTZ      NOT NULL DEFAULT now(),
+    FOREIGN KEY (stock_id) REFERENCES stocks (id)
+        ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS alert_user_id_index ON alert (user_id);
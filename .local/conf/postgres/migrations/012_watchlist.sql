@@ -0,0 +1,22 @@
+-- per-user watchlists
+
+CREATE TABLE IF NOT EXISTS "watchlist"
+(
+    "id"         BIGSERIAL PRIMARY KEY,
+    "user_id"    VARCHAR(255) NOT NULL,
+    "name"       VARCHAR(255) NOT NULL,
+    "created_at" TIMESTAMPTZ  NOT NULL DEFAULT now(),
+    UNIQUE ("user_id", "name")
+);
+
+CREATE TABLE IF NOT EXISTS "watchlist_item"
+(
+    "watchlist_id" BIGINT  NOT NULL,
+    "stock_id"     BIGINT  NOT NULL,
+    "position"     INTEGER NOT NULL,
+    PRIMARY KEY ("watchlist_id", "stock_id"),
+    FOREIGN KEY (watchlist_id) REFERENCES watchlist (id)
+        ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (stock_id) REFERENCES stocks (id)
+        ON UPDATE CASCADE ON DELETE CASCADE
+);
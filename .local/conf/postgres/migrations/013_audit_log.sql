@@ -0,0 +1,33 @@
+-- audit log of Board operations
+
+ALTER TABLE subject ADD COLUMN IF NOT EXISTS "created_at" TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE subject ADD COLUMN IF NOT EXISTS "updated_at" TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE question ADD COLUMN IF NOT EXISTS "updated_at" TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE TABLE IF NOT EXISTS "audit_log"
+(
+    "id"          BIGSERIAL PRIMARY KEY,
+    "actor_id"    VARCHAR(255) NOT NULL DEFAULT '',
+    "method"      VARCHAR(100) NOT NULL,
+    "target_type" VARCHAR(20)  NOT NULL,
+    "target_id"   BIGINT       NOT NULL,
+    "before"      JSONB,
+    "after"       JSONB,
+    "request_id"  VARCHAR(128) NOT NULL DEFAULT '',
+    "created_at"  TIMESTAMPTZ  NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_target_index ON audit_log (target_type, target_id);
+CREATE INDEX IF NOT EXISTS audit_log_actor_id_index ON audit_log (actor_id);
+CREATE INDEX IF NOT EXISTS audit_log_request_id_index ON audit_log (request_id);
+CREATE INDEX IF NOT EXISTS audit_log_created_at_index ON audit_log (created_at);
+
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
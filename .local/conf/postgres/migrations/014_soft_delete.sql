@@ -0,0 +1,12 @@
+-- soft delete of subjects and questions; a subject with questions can only
+-- be removed by the purger, which deletes its questions first
+
+ALTER TABLE subject ADD COLUMN IF NOT EXISTS "deleted_at" TIMESTAMPTZ;
+ALTER TABLE question ADD COLUMN IF NOT EXISTS "deleted_at" TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS subject_deleted_at_index ON subject (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS question_deleted_at_index ON question (deleted_at) WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE question DROP CONSTRAINT IF EXISTS question_subject_id_fkey;
+ALTER TABLE question ADD CONSTRAINT question_subject_id_fkey FOREIGN KEY (subject_id) REFERENCES subject (id)
+    ON UPDATE CASCADE ON DELETE RESTRICT;
@@ -0,0 +1,5 @@
+-- order and trade prices are kept in cents like the account ledger, so the
+-- cash reserved by open orders sums exactly
+
+ALTER TABLE orders ALTER COLUMN "price" TYPE NUMERIC(20, 2);
+ALTER TABLE trade ALTER COLUMN "price" TYPE NUMERIC(20, 2);
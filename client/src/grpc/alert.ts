@@ -0,0 +1,1003 @@
+/* eslint-disable */
+import {
+  CallOptions,
+  ChannelCredentials,
+  Client,
+  ClientOptions,
+  ClientReadableStream,
+  ClientUnaryCall,
+  handleServerStreamingCall,
+  handleUnaryCall,
+  makeGenericClientConstructor,
+  Metadata,
+  ServiceError,
+  UntypedServiceImplementation,
+} from "@grpc/grpc-js";
+import Long from "long";
+import _m0 from "protobufjs/minimal";
+import { Duration } from "./google/protobuf/duration";
+import { Empty } from "./google/protobuf/empty";
+import { Timestamp } from "./google/protobuf/timestamp";
+
+export const protobufPackage = "alert";
+
+export enum AlertType {
+  ALERT_TYPE_UNSPECIFIED = 0,
+  PRICE_ABOVE = 1,
+  PRICE_BELOW = 2,
+  PERCENT_MOVE = 3,
+  VOLUME_SPIKE = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function alertTypeFromJSON(object: any): AlertType {
+  switch (object) {
+    case 0:
+    case "ALERT_TYPE_UNSPECIFIED":
+      return AlertType.ALERT_TYPE_UNSPECIFIED;
+    case 1:
+    case "PRICE_ABOVE":
+      return AlertType.PRICE_ABOVE;
+    case 2:
+    case "PRICE_BELOW":
+      return AlertType.PRICE_BELOW;
+    case 3:
+    case "PERCENT_MOVE":
+      return AlertType.PERCENT_MOVE;
+    case 4:
+    case "VOLUME_SPIKE":
+      return AlertType.VOLUME_SPIKE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return AlertType.UNRECOGNIZED;
+  }
+}
+
+export function alertTypeToJSON(object: AlertType): string {
+  switch (object) {
+    case AlertType.ALERT_TYPE_UNSPECIFIED:
+      return "ALERT_TYPE_UNSPECIFIED";
+    case AlertType.PRICE_ABOVE:
+      return "PRICE_ABOVE";
+    case AlertType.PRICE_BELOW:
+      return "PRICE_BELOW";
+    case AlertType.PERCENT_MOVE:
+      return "PERCENT_MOVE";
+    case AlertType.VOLUME_SPIKE:
+      return "VOLUME_SPIKE";
+    case AlertType.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export interface NewAlert {
+  code: string;
+  type: AlertType;
+  threshold: number;
+  window: Duration | undefined;
+  webhookUrl: string;
+}
+
+export interface Alert {
+  id: number;
+  userId: string;
+  code: string;
+  type: AlertType;
+  threshold: number;
+  window: Duration | undefined;
+  webhookUrl: string;
+  snoozedUntil: Date | undefined;
+  lastTriggeredAt: Date | undefined;
+  createdAt: Date | undefined;
+}
+
+export interface AlertId {
+  id: number;
+}
+
+export interface AlertList {
+  alertList: Alert[];
+}
+
+export interface AlertSnooze {
+  id: number;
+  until: Date | undefined;
+}
+
+export interface AlertTrigger {
+  alertId: number;
+  userId: string;
+  code: string;
+  type: AlertType;
+  threshold: number;
+  value: number;
+  price: number;
+  volume: number;
+  time: Date | undefined;
+}
+
+function createBaseNewAlert(): NewAlert {
+  return { code: "", type: 0, threshold: 0, window: undefined, webhookUrl: "" };
+}
+
+export const NewAlert = {
+  encode(message: NewAlert, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    if (message.type !== 0) {
+      writer.uint32(16).int32(message.type);
+    }
+    if (message.threshold !== 0) {
+      writer.uint32(25).double(message.threshold);
+    }
+    if (message.window !== undefined) {
+      Duration.encode(message.window, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.webhookUrl !== "") {
+      writer.uint32(42).string(message.webhookUrl);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): NewAlert {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseNewAlert();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 3:
+          if (tag !== 25) {
+            break;
+          }
+
+          message.threshold = reader.double();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.window = Duration.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.webhookUrl = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): NewAlert {
+    return {
+      code: isSet(object.code) ? String(object.code) : "",
+      type: isSet(object.type) ? alertTypeFromJSON(object.type) : 0,
+      threshold: isSet(object.threshold) ? Number(object.threshold) : 0,
+      window: isSet(object.window) ? Duration.fromJSON(object.window) : undefined,
+      webhookUrl: isSet(object.webhookUrl) ? String(object.webhookUrl) : "",
+    };
+  },
+
+  toJSON(message: NewAlert): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.type !== 0) {
+      obj.type = alertTypeToJSON(message.type);
+    }
+    if (message.threshold !== 0) {
+      obj.threshold = message.threshold;
+    }
+    if (message.window !== undefined) {
+      obj.window = Duration.toJSON(message.window);
+    }
+    if (message.webhookUrl !== "") {
+      obj.webhookUrl = message.webhookUrl;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<NewAlert>, I>>(base?: I): NewAlert {
+    return NewAlert.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<NewAlert>, I>>(object: I): NewAlert {
+    const message = createBaseNewAlert();
+    message.code = object.code ?? "";
+    message.type = object.type ?? 0;
+    message.threshold = object.threshold ?? 0;
+    message.window = (object.window !== undefined && object.window !== null)
+      ? Duration.fromPartial(object.window)
+      : undefined;
+    message.webhookUrl = object.webhookUrl ?? "";
+    return message;
+  },
+};
+
+function createBaseAlert(): Alert {
+  return {
+    id: 0,
+    userId: "",
+    code: "",
+    type: 0,
+    threshold: 0,
+    window: undefined,
+    webhookUrl: "",
+    snoozedUntil: undefined,
+    lastTriggeredAt: undefined,
+    createdAt: undefined,
+  };
+}
+
+export const Alert = {
+  encode(message: Alert, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.userId !== "") {
+      writer.uint32(18).string(message.userId);
+    }
+    if (message.code !== "") {
+      writer.uint32(26).string(message.code);
+    }
+    if (message.type !== 0) {
+      writer.uint32(32).int32(message.type);
+    }
+    if (message.threshold !== 0) {
+      writer.uint32(41).double(message.threshold);
+    }
+    if (message.window !== undefined) {
+      Duration.encode(message.window, writer.uint32(50).fork()).ldelim();
+    }
+    if (message.webhookUrl !== "") {
+      writer.uint32(58).string(message.webhookUrl);
+    }
+    if (message.snoozedUntil !== undefined) {
+      Timestamp.encode(toTimestamp(message.snoozedUntil), writer.uint32(66).fork()).ldelim();
+    }
+    if (message.lastTriggeredAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastTriggeredAt), writer.uint32(74).fork()).ldelim();
+    }
+    if (message.createdAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAt), writer.uint32(82).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Alert {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlert();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.userId = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 5:
+          if (tag !== 41) {
+            break;
+          }
+
+          message.threshold = reader.double();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.window = Duration.decode(reader, reader.uint32());
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.webhookUrl = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.snoozedUntil = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.lastTriggeredAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.createdAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Alert {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      userId: isSet(object.userId) ? String(object.userId) : "",
+      code: isSet(object.code) ? String(object.code) : "",
+      type: isSet(object.type) ? alertTypeFromJSON(object.type) : 0,
+      threshold: isSet(object.threshold) ? Number(object.threshold) : 0,
+      window: isSet(object.window) ? Duration.fromJSON(object.window) : undefined,
+      webhookUrl: isSet(object.webhookUrl) ? String(object.webhookUrl) : "",
+      snoozedUntil: isSet(object.snoozedUntil) ? fromJsonTimestamp(object.snoozedUntil) : undefined,
+      lastTriggeredAt: isSet(object.lastTriggeredAt) ? fromJsonTimestamp(object.lastTriggeredAt) : undefined,
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+    };
+  },
+
+  toJSON(message: Alert): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.userId !== "") {
+      obj.userId = message.userId;
+    }
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.type !== 0) {
+      obj.type = alertTypeToJSON(message.type);
+    }
+    if (message.threshold !== 0) {
+      obj.threshold = message.threshold;
+    }
+    if (message.window !== undefined) {
+      obj.window = Duration.toJSON(message.window);
+    }
+    if (message.webhookUrl !== "") {
+      obj.webhookUrl = message.webhookUrl;
+    }
+    if (message.snoozedUntil !== undefined) {
+      obj.snoozedUntil = message.snoozedUntil.toISOString();
+    }
+    if (message.lastTriggeredAt !== undefined) {
+      obj.lastTriggeredAt = message.lastTriggeredAt.toISOString();
+    }
+    if (message.createdAt !== undefined) {
+      obj.createdAt = message.createdAt.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Alert>, I>>(base?: I): Alert {
+    return Alert.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<Alert>, I>>(object: I): Alert {
+    const message = createBaseAlert();
+    message.id = object.id ?? 0;
+    message.userId = object.userId ?? "";
+    message.code = object.code ?? "";
+    message.type = object.type ?? 0;
+    message.threshold = object.threshold ?? 0;
+    message.window = (object.window !== undefined && object.window !== null)
+      ? Duration.fromPartial(object.window)
+      : undefined;
+    message.webhookUrl = object.webhookUrl ?? "";
+    message.snoozedUntil = object.snoozedUntil ?? undefined;
+    message.lastTriggeredAt = object.lastTriggeredAt ?? undefined;
+    message.createdAt = object.createdAt ?? undefined;
+    return message;
+  },
+};
+
+function createBaseAlertId(): AlertId {
+  return { id: 0 };
+}
+
+export const AlertId = {
+  encode(message: AlertId, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AlertId {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlertId();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AlertId {
+    return { id: isSet(object.id) ? Number(object.id) : 0 };
+  },
+
+  toJSON(message: AlertId): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AlertId>, I>>(base?: I): AlertId {
+    return AlertId.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<AlertId>, I>>(object: I): AlertId {
+    const message = createBaseAlertId();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseAlertList(): AlertList {
+  return { alertList: [] };
+}
+
+export const AlertList = {
+  encode(message: AlertList, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.alertList) {
+      Alert.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AlertList {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlertList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.alertList.push(Alert.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AlertList {
+    return { alertList: Array.isArray(object?.alertList) ? object.alertList.map((e: any) => Alert.fromJSON(e)) : [] };
+  },
+
+  toJSON(message: AlertList): unknown {
+    const obj: any = {};
+    if (message.alertList?.length) {
+      obj.alertList = message.alertList.map((e) => Alert.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AlertList>, I>>(base?: I): AlertList {
+    return AlertList.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<AlertList>, I>>(object: I): AlertList {
+    const message = createBaseAlertList();
+    message.alertList = object.alertList?.map((e) => Alert.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseAlertSnooze(): AlertSnooze {
+  return { id: 0, until: undefined };
+}
+
+export const AlertSnooze = {
+  encode(message: AlertSnooze, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.until !== undefined) {
+      Timestamp.encode(toTimestamp(message.until), writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AlertSnooze {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlertSnooze();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.until = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AlertSnooze {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      until: isSet(object.until) ? fromJsonTimestamp(object.until) : undefined,
+    };
+  },
+
+  toJSON(message: AlertSnooze): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.until !== undefined) {
+      obj.until = message.until.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AlertSnooze>, I>>(base?: I): AlertSnooze {
+    return AlertSnooze.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<AlertSnooze>, I>>(object: I): AlertSnooze {
+    const message = createBaseAlertSnooze();
+    message.id = object.id ?? 0;
+    message.until = object.until ?? undefined;
+    return message;
+  },
+};
+
+function createBaseAlertTrigger(): AlertTrigger {
+  return { alertId: 0, userId: "", code: "", type: 0, threshold: 0, value: 0, price: 0, volume: 0, time: undefined };
+}
+
+export const AlertTrigger = {
+  encode(message: AlertTrigger, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.alertId !== 0) {
+      writer.uint32(8).int64(message.alertId);
+    }
+    if (message.userId !== "") {
+      writer.uint32(18).string(message.userId);
+    }
+    if (message.code !== "") {
+      writer.uint32(26).string(message.code);
+    }
+    if (message.type !== 0) {
+      writer.uint32(32).int32(message.type);
+    }
+    if (message.threshold !== 0) {
+      writer.uint32(41).double(message.threshold);
+    }
+    if (message.value !== 0) {
+      writer.uint32(49).double(message.value);
+    }
+    if (message.price !== 0) {
+      writer.uint32(57).double(message.price);
+    }
+    if (message.volume !== 0) {
+      writer.uint32(64).int64(message.volume);
+    }
+    if (message.time !== undefined) {
+      Timestamp.encode(toTimestamp(message.time), writer.uint32(74).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AlertTrigger {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAlertTrigger();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.alertId = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.userId = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 5:
+          if (tag !== 41) {
+            break;
+          }
+
+          message.threshold = reader.double();
+          continue;
+        case 6:
+          if (tag !== 49) {
+            break;
+          }
+
+          message.value = reader.double();
+          continue;
+        case 7:
+          if (tag !== 57) {
+            break;
+          }
+
+          message.price = reader.double();
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.volume = longToNumber(reader.int64() as Long);
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AlertTrigger {
+    return {
+      alertId: isSet(object.alertId) ? Number(object.alertId) : 0,
+      userId: isSet(object.userId) ? String(object.userId) : "",
+      code: isSet(object.code) ? String(object.code) : "",
+      type: isSet(object.type) ? alertTypeFromJSON(object.type) : 0,
+      threshold: isSet(object.threshold) ? Number(object.threshold) : 0,
+      value: isSet(object.value) ? Number(object.value) : 0,
+      price: isSet(object.price) ? Number(object.price) : 0,
+      volume: isSet(object.volume) ? Number(object.volume) : 0,
+      time: isSet(object.time) ? fromJsonTimestamp(object.time) : undefined,
+    };
+  },
+
+  toJSON(message: AlertTrigger): unknown {
+    const obj: any = {};
+    if (message.alertId !== 0) {
+      obj.alertId = Math.round(message.alertId);
+    }
+    if (message.userId !== "") {
+      obj.userId = message.userId;
+    }
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.type !== 0) {
+      obj.type = alertTypeToJSON(message.type);
+    }
+    if (message.threshold !== 0) {
+      obj.threshold = message.threshold;
+    }
+    if (message.value !== 0) {
+      obj.value = message.value;
+    }
+    if (message.price !== 0) {
+      obj.price = message.price;
+    }
+    if (message.volume !== 0) {
+      obj.volume = Math.round(message.volume);
+    }
+    if (message.time !== undefined) {
+      obj.time = message.time.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AlertTrigger>, I>>(base?: I): AlertTrigger {
+    return AlertTrigger.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<AlertTrigger>, I>>(object: I): AlertTrigger {
+    const message = createBaseAlertTrigger();
+    message.alertId = object.alertId ?? 0;
+    message.userId = object.userId ?? "";
+    message.code = object.code ?? "";
+    message.type = object.type ?? 0;
+    message.threshold = object.threshold ?? 0;
+    message.value = object.value ?? 0;
+    message.price = object.price ?? 0;
+    message.volume = object.volume ?? 0;
+    message.time = object.time ?? undefined;
+    return message;
+  },
+};
+
+export type AlertsService = typeof AlertsService;
+export const AlertsService = {
+  createAlert: {
+    path: "/alert.Alerts/CreateAlert",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: NewAlert) => Buffer.from(NewAlert.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => NewAlert.decode(value),
+    responseSerialize: (value: Alert) => Buffer.from(Alert.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Alert.decode(value),
+  },
+  listAlerts: {
+    path: "/alert.Alerts/ListAlerts",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => Empty.decode(value),
+    responseSerialize: (value: AlertList) => Buffer.from(AlertList.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => AlertList.decode(value),
+  },
+  deleteAlert: {
+    path: "/alert.Alerts/DeleteAlert",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: AlertId) => Buffer.from(AlertId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => AlertId.decode(value),
+    responseSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Empty.decode(value),
+  },
+  snoozeAlert: {
+    path: "/alert.Alerts/SnoozeAlert",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: AlertSnooze) => Buffer.from(AlertSnooze.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => AlertSnooze.decode(value),
+    responseSerialize: (value: Alert) => Buffer.from(Alert.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Alert.decode(value),
+  },
+  streamAlerts: {
+    path: "/alert.Alerts/StreamAlerts",
+    requestStream: false,
+    responseStream: true,
+    requestSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => Empty.decode(value),
+    responseSerialize: (value: AlertTrigger) => Buffer.from(AlertTrigger.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => AlertTrigger.decode(value),
+  },
+} as const;
+
+export interface AlertsServer extends UntypedServiceImplementation {
+  createAlert: handleUnaryCall<NewAlert, Alert>;
+  listAlerts: handleUnaryCall<Empty, AlertList>;
+  deleteAlert: handleUnaryCall<AlertId, Empty>;
+  snoozeAlert: handleUnaryCall<AlertSnooze, Alert>;
+  streamAlerts: handleServerStreamingCall<Empty, AlertTrigger>;
+}
+
+export interface AlertsClient extends Client {
+  createAlert(request: NewAlert, callback: (error: ServiceError | null, response: Alert) => void): ClientUnaryCall;
+  createAlert(
+    request: NewAlert,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Alert) => void,
+  ): ClientUnaryCall;
+  createAlert(
+    request: NewAlert,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Alert) => void,
+  ): ClientUnaryCall;
+  listAlerts(request: Empty, callback: (error: ServiceError | null, response: AlertList) => void): ClientUnaryCall;
+  listAlerts(
+    request: Empty,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: AlertList) => void,
+  ): ClientUnaryCall;
+  listAlerts(
+    request: Empty,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: AlertList) => void,
+  ): ClientUnaryCall;
+  deleteAlert(request: AlertId, callback: (error: ServiceError | null, response: Empty) => void): ClientUnaryCall;
+  deleteAlert(
+    request: AlertId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Empty) => void,
+  ): ClientUnaryCall;
+  deleteAlert(
+    request: AlertId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Empty) => void,
+  ): ClientUnaryCall;
+  snoozeAlert(request: AlertSnooze, callback: (error: ServiceError | null, response: Alert) => void): ClientUnaryCall;
+  snoozeAlert(
+    request: AlertSnooze,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Alert) => void,
+  ): ClientUnaryCall;
+  snoozeAlert(
+    request: AlertSnooze,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Alert) => void,
+  ): ClientUnaryCall;
+  streamAlerts(request: Empty, options?: Partial<CallOptions>): ClientReadableStream<AlertTrigger>;
+  streamAlerts(request: Empty, metadata?: Metadata, options?: Partial<CallOptions>): ClientReadableStream<AlertTrigger>;
+}
+
+export const AlertsClient = makeGenericClientConstructor(AlertsService, "alert.Alerts") as unknown as {
+  new (address: string, credentials: ChannelCredentials, options?: Partial<ClientOptions>): AlertsClient;
+  service: typeof AlertsService;
+};
+
+declare const self: any | undefined;
+declare const window: any | undefined;
+declare const global: any | undefined;
+const tsProtoGlobalThis: any = (() => {
+  if (typeof globalThis !== "undefined") {
+    return globalThis;
+  }
+  if (typeof self !== "undefined") {
+    return self;
+  }
+  if (typeof window !== "undefined") {
+    return window;
+  }
+  if (typeof global !== "undefined") {
+    return global;
+  }
+  throw "Unable to locate global object";
+})();
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends Array<infer U> ? Array<DeepPartial<U>> : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+type KeysOfUnion<T> = T extends T ? keyof T : never;
+export type Exact<P, I extends P> = P extends Builtin ? P
+  : P & { [K in keyof P]: Exact<P[K], I[K]> } & { [K in Exclude<keyof I, KeysOfUnion<P>>]: never };
+
+function toTimestamp(date: Date): Timestamp {
+  const seconds = Math.trunc(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function longToNumber(long: Long): number {
+  if (long.gt(Number.MAX_SAFE_INTEGER)) {
+    throw new tsProtoGlobalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
+  }
+  return long.toNumber();
+}
+
+if (_m0.util.Long !== Long) {
+  _m0.util.Long = Long as any;
+  _m0.configure();
+}
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
@@ -4,7 +4,9 @@ import {
   ChannelCredentials,
   Client,
   ClientOptions,
+  ClientReadableStream,
   ClientUnaryCall,
+  handleServerStreamingCall,
   handleUnaryCall,
   makeGenericClientConstructor,
   Metadata,
@@ -14,6 +16,7 @@ import {
 import Long from "long";
 import _m0 from "protobufjs/minimal";
 import { Empty } from "./google/protobuf/empty";
+import { Timestamp } from "./google/protobuf/timestamp";
 
 export const protobufPackage = "board";
 
@@ -30,6 +33,76 @@ export interface Subject {
   id: number;
   title: string;
   enabled: boolean;
+  tags: Tag[];
+  opensAt: Date | undefined;
+  closesAt: Date | undefined;
+  closedAt: Date | undefined;
+  createdAt: Date | undefined;
+  updatedAt: Date | undefined;
+}
+
+export interface SubjectSchedule {
+  id: number;
+  opensAt: Date | undefined;
+  closesAt: Date | undefined;
+}
+
+export interface RankedQuestion {
+  rank: number;
+  questionId: number;
+  question: string;
+  likesCount: number;
+}
+
+export interface Ranking {
+  subjectId: number;
+  closedAt: Date | undefined;
+  ranking: RankedQuestion[];
+}
+
+export interface SubjectEvent {
+  type: SubjectEvent_Type;
+  subject: Subject | undefined;
+  occurredAt: Date | undefined;
+}
+
+export enum SubjectEvent_Type {
+  UNKNOWN = 0,
+  OPENED = 1,
+  CLOSED = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function subjectEvent_TypeFromJSON(object: any): SubjectEvent_Type {
+  switch (object) {
+    case 0:
+    case "UNKNOWN":
+      return SubjectEvent_Type.UNKNOWN;
+    case 1:
+    case "OPENED":
+      return SubjectEvent_Type.OPENED;
+    case 2:
+    case "CLOSED":
+      return SubjectEvent_Type.CLOSED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return SubjectEvent_Type.UNRECOGNIZED;
+  }
+}
+
+export function subjectEvent_TypeToJSON(object: SubjectEvent_Type): string {
+  switch (object) {
+    case SubjectEvent_Type.UNKNOWN:
+      return "UNKNOWN";
+    case SubjectEvent_Type.OPENED:
+      return "OPENED";
+    case SubjectEvent_Type.CLOSED:
+      return "CLOSED";
+    case SubjectEvent_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
 }
 
 export interface SubjectId {
@@ -41,10 +114,83 @@ export interface NewQuestion {
   subjectId: number;
 }
 
+export interface EditedQuestion {
+  id: number;
+  question: string;
+}
+
 export interface Question {
   id: number;
   question: string;
   likesCount: number;
+  authorId: string;
+  createdAt: Date | undefined;
+  answered: boolean;
+  tags: string[];
+  updatedAt: Date | undefined;
+}
+
+export interface QuestionFilter {
+  subjectId: number;
+  tags: string[];
+  matchAll: boolean;
+}
+
+export interface QuestionTags {
+  questionId: number;
+  tags: string[];
+}
+
+export interface NewTag {
+  subjectId: number;
+  name: string;
+}
+
+export interface Tag {
+  id: number;
+  subjectId: number;
+  name: string;
+  questionCount: number;
+}
+
+export interface TagId {
+  id: number;
+}
+
+export interface TagList {
+  tagList: Tag[];
+}
+
+export interface QuestionQuery {
+  query: string;
+  subjectId: number;
+  createdFrom: Date | undefined;
+  createdTo: Date | undefined;
+  answered?: boolean | undefined;
+  limit: number;
+}
+
+export interface QuestionMatch {
+  question: Question | undefined;
+  rank: number;
+  snippet: string;
+}
+
+export interface QuestionMatchList {
+  matchList: QuestionMatch[];
+}
+
+export interface QuestionEdit {
+  id: number;
+  questionId: number;
+  action: string;
+  question: string;
+  editorId: string;
+  editedAt: Date | undefined;
+}
+
+export interface QuestionEditList {
+  editList: QuestionEdit[];
 }
 
 export interface QuestionList {
@@ -59,6 +205,34 @@ export interface QuestionId {
   id: number;
 }
 
+export interface AuditLogFilter {
+  actorId: string;
+  method: string;
+  targetType: string;
+  targetId: number;
+  requestId: string;
+  createdFrom: Date | undefined;
+  createdTo: Date | undefined;
+  beforeId: number;
+  limit: number;
+}
+
+export interface AuditLogEntry {
+  id: number;
+  actorId: string;
+  method: string;
+  targetType: string;
+  targetId: number;
+  before: string;
+  after: string;
+  requestId: string;
+  createdAt: Date | undefined;
+}
+
+export interface AuditLogList {
+  entryList: AuditLogEntry[];
+}
+
 function createBaseLikes(): Likes {
   return { userId: "", questionId: 0 };
 }
@@ -193,7 +367,17 @@ export const NewSubject = {
 };
 
 function createBaseSubject(): Subject {
-  return { id: 0, title: "", enabled: false };
+  return {
+    id: 0,
+    title: "",
+    enabled: false,
+    tags: [],
+    opensAt: undefined,
+    closesAt: undefined,
+    closedAt: undefined,
+    createdAt: undefined,
+    updatedAt: undefined,
+  };
 }
 
 export const Subject = {
@@ -207,6 +391,24 @@ export const Subject = {
     if (message.enabled === true) {
       writer.uint32(24).bool(message.enabled);
     }
+    for (const v of message.tags) {
+      Tag.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.opensAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.opensAt), writer.uint32(42).fork()).ldelim();
+    }
+    if (message.closesAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.closesAt), writer.uint32(50).fork()).ldelim();
+    }
+    if (message.closedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.closedAt), writer.uint32(58).fork()).ldelim();
+    }
+    if (message.createdAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAt), writer.uint32(66).fork()).ldelim();
+    }
+    if (message.updatedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.updatedAt), writer.uint32(74).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -238,6 +440,48 @@ export const Subject = {
 
           message.enabled = reader.bool();
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.tags.push(Tag.decode(reader, reader.uint32()));
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.opensAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.closesAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.closedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.createdAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.updatedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -252,6 +496,12 @@ export const Subject = {
       id: isSet(object.id) ? Number(object.id) : 0,
       title: isSet(object.title) ? String(object.title) : "",
       enabled: isSet(object.enabled) ? Boolean(object.enabled) : false,
+      tags: Array.isArray(object?.tags) ? object.tags.map((e: any) => Tag.fromJSON(e)) : [],
+      opensAt: isSet(object.opensAt) ? fromJsonTimestamp(object.opensAt) : undefined,
+      closesAt: isSet(object.closesAt) ? fromJsonTimestamp(object.closesAt) : undefined,
+      closedAt: isSet(object.closedAt) ? fromJsonTimestamp(object.closedAt) : undefined,
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+      updatedAt: isSet(object.updatedAt) ? fromJsonTimestamp(object.updatedAt) : undefined,
     };
   },
 
@@ -266,6 +516,24 @@ export const Subject = {
     if (message.enabled === true) {
       obj.enabled = message.enabled;
     }
+    if (message.tags?.length) {
+      obj.tags = message.tags.map((e) => Tag.toJSON(e));
+    }
+    if (message.opensAt !== undefined) {
+      obj.opensAt = message.opensAt.toISOString();
+    }
+    if (message.closesAt !== undefined) {
+      obj.closesAt = message.closesAt.toISOString();
+    }
+    if (message.closedAt !== undefined) {
+      obj.closedAt = message.closedAt.toISOString();
+    }
+    if (message.createdAt !== undefined) {
+      obj.createdAt = message.createdAt.toISOString();
+    }
+    if (message.updatedAt !== undefined) {
+      obj.updatedAt = message.updatedAt.toISOString();
+    }
     return obj;
   },
 
@@ -278,26 +546,38 @@ export const Subject = {
     message.id = object.id ?? 0;
     message.title = object.title ?? "";
     message.enabled = object.enabled ?? false;
+    message.tags = object.tags?.map((e) => Tag.fromPartial(e)) || [];
+    message.opensAt = object.opensAt ?? undefined;
+    message.closesAt = object.closesAt ?? undefined;
+    message.closedAt = object.closedAt ?? undefined;
+    message.createdAt = object.createdAt ?? undefined;
+    message.updatedAt = object.updatedAt ?? undefined;
     return message;
   },
 };
 
-function createBaseSubjectId(): SubjectId {
-  return { id: 0 };
+function createBaseSubjectSchedule(): SubjectSchedule {
+  return { id: 0, opensAt: undefined, closesAt: undefined };
 }
 
-export const SubjectId = {
-  encode(message: SubjectId, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const SubjectSchedule = {
+  encode(message: SubjectSchedule, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.id !== 0) {
       writer.uint32(8).int64(message.id);
     }
+    if (message.opensAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.opensAt), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.closesAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.closesAt), writer.uint32(26).fork()).ldelim();
+    }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): SubjectId {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SubjectSchedule {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSubjectId();
+    const message = createBaseSubjectSchedule();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -308,6 +588,20 @@ export const SubjectId = {
 
           message.id = longToNumber(reader.int64() as Long);
           continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.opensAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.closesAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -317,64 +611,96 @@ export const SubjectId = {
     return message;
   },
 
-  fromJSON(object: any): SubjectId {
-    return { id: isSet(object.id) ? Number(object.id) : 0 };
+  fromJSON(object: any): SubjectSchedule {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      opensAt: isSet(object.opensAt) ? fromJsonTimestamp(object.opensAt) : undefined,
+      closesAt: isSet(object.closesAt) ? fromJsonTimestamp(object.closesAt) : undefined,
+    };
   },
 
-  toJSON(message: SubjectId): unknown {
+  toJSON(message: SubjectSchedule): unknown {
     const obj: any = {};
     if (message.id !== 0) {
       obj.id = Math.round(message.id);
     }
+    if (message.opensAt !== undefined) {
+      obj.opensAt = message.opensAt.toISOString();
+    }
+    if (message.closesAt !== undefined) {
+      obj.closesAt = message.closesAt.toISOString();
+    }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<SubjectId>, I>>(base?: I): SubjectId {
-    return SubjectId.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<SubjectSchedule>, I>>(base?: I): SubjectSchedule {
+    return SubjectSchedule.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<SubjectId>, I>>(object: I): SubjectId {
-    const message = createBaseSubjectId();
+  fromPartial<I extends Exact<DeepPartial<SubjectSchedule>, I>>(object: I): SubjectSchedule {
+    const message = createBaseSubjectSchedule();
     message.id = object.id ?? 0;
+    message.opensAt = object.opensAt ?? undefined;
+    message.closesAt = object.closesAt ?? undefined;
     return message;
   },
 };
 
-function createBaseNewQuestion(): NewQuestion {
-  return { question: "", subjectId: 0 };
+function createBaseRankedQuestion(): RankedQuestion {
+  return { rank: 0, questionId: 0, question: "", likesCount: 0 };
 }
 
-export const NewQuestion = {
-  encode(message: NewQuestion, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const RankedQuestion = {
+  encode(message: RankedQuestion, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.rank !== 0) {
+      writer.uint32(8).int32(message.rank);
+    }
+    if (message.questionId !== 0) {
+      writer.uint32(16).int64(message.questionId);
+    }
     if (message.question !== "") {
-      writer.uint32(10).string(message.question);
+      writer.uint32(26).string(message.question);
     }
-    if (message.subjectId !== 0) {
-      writer.uint32(16).int64(message.subjectId);
+    if (message.likesCount !== 0) {
+      writer.uint32(32).int64(message.likesCount);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): NewQuestion {
+  decode(input: _m0.Reader | Uint8Array, length?: number): RankedQuestion {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseNewQuestion();
+    const message = createBaseRankedQuestion();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.question = reader.string();
+          message.rank = reader.int32();
           continue;
         case 2:
           if (tag !== 16) {
             break;
           }
 
-          message.subjectId = longToNumber(reader.int64() as Long);
+          message.questionId = longToNumber(reader.int64() as Long);
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.question = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.likesCount = longToNumber(reader.int64() as Long);
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -385,58 +711,68 @@ export const NewQuestion = {
     return message;
   },
 
-  fromJSON(object: any): NewQuestion {
+  fromJSON(object: any): RankedQuestion {
     return {
+      rank: isSet(object.rank) ? Number(object.rank) : 0,
+      questionId: isSet(object.questionId) ? Number(object.questionId) : 0,
       question: isSet(object.question) ? String(object.question) : "",
-      subjectId: isSet(object.subjectId) ? Number(object.subjectId) : 0,
+      likesCount: isSet(object.likesCount) ? Number(object.likesCount) : 0,
     };
   },
 
-  toJSON(message: NewQuestion): unknown {
+  toJSON(message: RankedQuestion): unknown {
     const obj: any = {};
+    if (message.rank !== 0) {
+      obj.rank = Math.round(message.rank);
+    }
+    if (message.questionId !== 0) {
+      obj.questionId = Math.round(message.questionId);
+    }
     if (message.question !== "") {
       obj.question = message.question;
     }
-    if (message.subjectId !== 0) {
-      obj.subjectId = Math.round(message.subjectId);
+    if (message.likesCount !== 0) {
+      obj.likesCount = Math.round(message.likesCount);
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<NewQuestion>, I>>(base?: I): NewQuestion {
-    return NewQuestion.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<RankedQuestion>, I>>(base?: I): RankedQuestion {
+    return RankedQuestion.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<NewQuestion>, I>>(object: I): NewQuestion {
-    const message = createBaseNewQuestion();
+  fromPartial<I extends Exact<DeepPartial<RankedQuestion>, I>>(object: I): RankedQuestion {
+    const message = createBaseRankedQuestion();
+    message.rank = object.rank ?? 0;
+    message.questionId = object.questionId ?? 0;
     message.question = object.question ?? "";
-    message.subjectId = object.subjectId ?? 0;
+    message.likesCount = object.likesCount ?? 0;
     return message;
   },
 };
 
-function createBaseQuestion(): Question {
-  return { id: 0, question: "", likesCount: 0 };
+function createBaseRanking(): Ranking {
+  return { subjectId: 0, closedAt: undefined, ranking: [] };
 }
 
-export const Question = {
-  encode(message: Question, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.id !== 0) {
-      writer.uint32(8).int64(message.id);
+export const Ranking = {
+  encode(message: Ranking, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.subjectId !== 0) {
+      writer.uint32(8).int64(message.subjectId);
     }
-    if (message.question !== "") {
-      writer.uint32(18).string(message.question);
+    if (message.closedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.closedAt), writer.uint32(18).fork()).ldelim();
     }
-    if (message.likesCount !== 0) {
-      writer.uint32(24).int64(message.likesCount);
+    for (const v of message.ranking) {
+      RankedQuestion.encode(v!, writer.uint32(26).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Question {
+  decode(input: _m0.Reader | Uint8Array, length?: number): Ranking {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQuestion();
+    const message = createBaseRanking();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -445,21 +781,21 @@ export const Question = {
             break;
           }
 
-          message.id = longToNumber(reader.int64() as Long);
+          message.subjectId = longToNumber(reader.int64() as Long);
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.question = reader.string();
+          message.closedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         case 3:
-          if (tag !== 24) {
+          if (tag !== 26) {
             break;
           }
 
-          message.likesCount = longToNumber(reader.int64() as Long);
+          message.ranking.push(RankedQuestion.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -470,66 +806,86 @@ export const Question = {
     return message;
   },
 
-  fromJSON(object: any): Question {
+  fromJSON(object: any): Ranking {
     return {
-      id: isSet(object.id) ? Number(object.id) : 0,
-      question: isSet(object.question) ? String(object.question) : "",
-      likesCount: isSet(object.likesCount) ? Number(object.likesCount) : 0,
+      subjectId: isSet(object.subjectId) ? Number(object.subjectId) : 0,
+      closedAt: isSet(object.closedAt) ? fromJsonTimestamp(object.closedAt) : undefined,
+      ranking: Array.isArray(object?.ranking) ? object.ranking.map((e: any) => RankedQuestion.fromJSON(e)) : [],
     };
   },
 
-  toJSON(message: Question): unknown {
+  toJSON(message: Ranking): unknown {
     const obj: any = {};
-    if (message.id !== 0) {
-      obj.id = Math.round(message.id);
+    if (message.subjectId !== 0) {
+      obj.subjectId = Math.round(message.subjectId);
     }
-    if (message.question !== "") {
-      obj.question = message.question;
+    if (message.closedAt !== undefined) {
+      obj.closedAt = message.closedAt.toISOString();
     }
-    if (message.likesCount !== 0) {
-      obj.likesCount = Math.round(message.likesCount);
+    if (message.ranking?.length) {
+      obj.ranking = message.ranking.map((e) => RankedQuestion.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<Question>, I>>(base?: I): Question {
-    return Question.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<Ranking>, I>>(base?: I): Ranking {
+    return Ranking.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<Question>, I>>(object: I): Question {
-    const message = createBaseQuestion();
-    message.id = object.id ?? 0;
-    message.question = object.question ?? "";
-    message.likesCount = object.likesCount ?? 0;
+  fromPartial<I extends Exact<DeepPartial<Ranking>, I>>(object: I): Ranking {
+    const message = createBaseRanking();
+    message.subjectId = object.subjectId ?? 0;
+    message.closedAt = object.closedAt ?? undefined;
+    message.ranking = object.ranking?.map((e) => RankedQuestion.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseQuestionList(): QuestionList {
-  return { questionList: [] };
+function createBaseSubjectEvent(): SubjectEvent {
+  return { type: 0, subject: undefined, occurredAt: undefined };
 }
 
-export const QuestionList = {
-  encode(message: QuestionList, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.questionList) {
-      Question.encode(v!, writer.uint32(10).fork()).ldelim();
+export const SubjectEvent = {
+  encode(message: SubjectEvent, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+    if (message.subject !== undefined) {
+      Subject.encode(message.subject, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.occurredAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.occurredAt), writer.uint32(26).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QuestionList {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SubjectEvent {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQuestionList();
+    const message = createBaseSubjectEvent();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.questionList.push(Question.decode(reader, reader.uint32()));
+          message.type = reader.int32() as any;
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.subject = Subject.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.occurredAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -540,27 +896,1424 @@ export const QuestionList = {
     return message;
   },
 
-  fromJSON(object: any): QuestionList {
+  fromJSON(object: any): SubjectEvent {
     return {
-      questionList: Array.isArray(object?.questionList)
-        ? object.questionList.map((e: any) => Question.fromJSON(e))
-        : [],
+      type: isSet(object.type) ? subjectEvent_TypeFromJSON(object.type) : 0,
+      subject: isSet(object.subject) ? Subject.fromJSON(object.subject) : undefined,
+      occurredAt: isSet(object.occurredAt) ? fromJsonTimestamp(object.occurredAt) : undefined,
     };
   },
 
-  toJSON(message: QuestionList): unknown {
+  toJSON(message: SubjectEvent): unknown {
     const obj: any = {};
-    if (message.questionList?.length) {
-      obj.questionList = message.questionList.map((e) => Question.toJSON(e));
+    if (message.type !== 0) {
+      obj.type = subjectEvent_TypeToJSON(message.type);
+    }
+    if (message.subject !== undefined) {
+      obj.subject = Subject.toJSON(message.subject);
+    }
+    if (message.occurredAt !== undefined) {
+      obj.occurredAt = message.occurredAt.toISOString();
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<QuestionList>, I>>(base?: I): QuestionList {
-    return QuestionList.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<SubjectEvent>, I>>(base?: I): SubjectEvent {
+    return SubjectEvent.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<QuestionList>, I>>(object: I): QuestionList {
+  fromPartial<I extends Exact<DeepPartial<SubjectEvent>, I>>(object: I): SubjectEvent {
+    const message = createBaseSubjectEvent();
+    message.type = object.type ?? 0;
+    message.subject = (object.subject !== undefined && object.subject !== null)
+      ? Subject.fromPartial(object.subject)
+      : undefined;
+    message.occurredAt = object.occurredAt ?? undefined;
+    return message;
+  },
+};
+
+function createBaseSubjectId(): SubjectId {
+  return { id: 0 };
+}
+
+export const SubjectId = {
+  encode(message: SubjectId, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SubjectId {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSubjectId();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SubjectId {
+    return { id: isSet(object.id) ? Number(object.id) : 0 };
+  },
+
+  toJSON(message: SubjectId): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SubjectId>, I>>(base?: I): SubjectId {
+    return SubjectId.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<SubjectId>, I>>(object: I): SubjectId {
+    const message = createBaseSubjectId();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseNewQuestion(): NewQuestion {
+  return { question: "", subjectId: 0 };
+}
+
+export const NewQuestion = {
+  encode(message: NewQuestion, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.question !== "") {
+      writer.uint32(10).string(message.question);
+    }
+    if (message.subjectId !== 0) {
+      writer.uint32(16).int64(message.subjectId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): NewQuestion {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseNewQuestion();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.question = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.subjectId = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): NewQuestion {
+    return {
+      question: isSet(object.question) ? String(object.question) : "",
+      subjectId: isSet(object.subjectId) ? Number(object.subjectId) : 0,
+    };
+  },
+
+  toJSON(message: NewQuestion): unknown {
+    const obj: any = {};
+    if (message.question !== "") {
+      obj.question = message.question;
+    }
+    if (message.subjectId !== 0) {
+      obj.subjectId = Math.round(message.subjectId);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<NewQuestion>, I>>(base?: I): NewQuestion {
+    return NewQuestion.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<NewQuestion>, I>>(object: I): NewQuestion {
+    const message = createBaseNewQuestion();
+    message.question = object.question ?? "";
+    message.subjectId = object.subjectId ?? 0;
+    return message;
+  },
+};
+
+function createBaseEditedQuestion(): EditedQuestion {
+  return { id: 0, question: "" };
+}
+
+export const EditedQuestion = {
+  encode(message: EditedQuestion, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.question !== "") {
+      writer.uint32(18).string(message.question);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): EditedQuestion {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEditedQuestion();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.question = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EditedQuestion {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      question: isSet(object.question) ? String(object.question) : "",
+    };
+  },
+
+  toJSON(message: EditedQuestion): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.question !== "") {
+      obj.question = message.question;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<EditedQuestion>, I>>(base?: I): EditedQuestion {
+    return EditedQuestion.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<EditedQuestion>, I>>(object: I): EditedQuestion {
+    const message = createBaseEditedQuestion();
+    message.id = object.id ?? 0;
+    message.question = object.question ?? "";
+    return message;
+  },
+};
+
+function createBaseQuestion(): Question {
+  return {
+    id: 0,
+    question: "",
+    likesCount: 0,
+    authorId: "",
+    createdAt: undefined,
+    answered: false,
+    tags: [],
+    updatedAt: undefined,
+  };
+}
+
+export const Question = {
+  encode(message: Question, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.question !== "") {
+      writer.uint32(18).string(message.question);
+    }
+    if (message.likesCount !== 0) {
+      writer.uint32(24).int64(message.likesCount);
+    }
+    if (message.authorId !== "") {
+      writer.uint32(34).string(message.authorId);
+    }
+    if (message.createdAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAt), writer.uint32(42).fork()).ldelim();
+    }
+    if (message.answered === true) {
+      writer.uint32(48).bool(message.answered);
+    }
+    for (const v of message.tags) {
+      writer.uint32(58).string(v!);
+    }
+    if (message.updatedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.updatedAt), writer.uint32(66).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Question {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuestion();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.question = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.likesCount = longToNumber(reader.int64() as Long);
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.authorId = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.createdAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.answered = reader.bool();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.tags.push(reader.string());
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.updatedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Question {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      question: isSet(object.question) ? String(object.question) : "",
+      likesCount: isSet(object.likesCount) ? Number(object.likesCount) : 0,
+      authorId: isSet(object.authorId) ? String(object.authorId) : "",
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+      answered: isSet(object.answered) ? Boolean(object.answered) : false,
+      tags: Array.isArray(object?.tags) ? object.tags.map((e: any) => String(e)) : [],
+      updatedAt: isSet(object.updatedAt) ? fromJsonTimestamp(object.updatedAt) : undefined,
+    };
+  },
+
+  toJSON(message: Question): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.question !== "") {
+      obj.question = message.question;
+    }
+    if (message.likesCount !== 0) {
+      obj.likesCount = Math.round(message.likesCount);
+    }
+    if (message.authorId !== "") {
+      obj.authorId = message.authorId;
+    }
+    if (message.createdAt !== undefined) {
+      obj.createdAt = message.createdAt.toISOString();
+    }
+    if (message.answered === true) {
+      obj.answered = message.answered;
+    }
+    if (message.tags?.length) {
+      obj.tags = message.tags;
+    }
+    if (message.updatedAt !== undefined) {
+      obj.updatedAt = message.updatedAt.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Question>, I>>(base?: I): Question {
+    return Question.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<Question>, I>>(object: I): Question {
+    const message = createBaseQuestion();
+    message.id = object.id ?? 0;
+    message.question = object.question ?? "";
+    message.likesCount = object.likesCount ?? 0;
+    message.authorId = object.authorId ?? "";
+    message.createdAt = object.createdAt ?? undefined;
+    message.answered = object.answered ?? false;
+    message.tags = object.tags?.map((e) => e) || [];
+    message.updatedAt = object.updatedAt ?? undefined;
+    return message;
+  },
+};
+
+function createBaseQuestionFilter(): QuestionFilter {
+  return { subjectId: 0, tags: [], matchAll: false };
+}
+
+export const QuestionFilter = {
+  encode(message: QuestionFilter, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.subjectId !== 0) {
+      writer.uint32(8).int64(message.subjectId);
+    }
+    for (const v of message.tags) {
+      writer.uint32(18).string(v!);
+    }
+    if (message.matchAll === true) {
+      writer.uint32(24).bool(message.matchAll);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuestionFilter {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuestionFilter();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.subjectId = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.tags.push(reader.string());
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.matchAll = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QuestionFilter {
+    return {
+      subjectId: isSet(object.subjectId) ? Number(object.subjectId) : 0,
+      tags: Array.isArray(object?.tags) ? object.tags.map((e: any) => String(e)) : [],
+      matchAll: isSet(object.matchAll) ? Boolean(object.matchAll) : false,
+    };
+  },
+
+  toJSON(message: QuestionFilter): unknown {
+    const obj: any = {};
+    if (message.subjectId !== 0) {
+      obj.subjectId = Math.round(message.subjectId);
+    }
+    if (message.tags?.length) {
+      obj.tags = message.tags;
+    }
+    if (message.matchAll === true) {
+      obj.matchAll = message.matchAll;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<QuestionFilter>, I>>(base?: I): QuestionFilter {
+    return QuestionFilter.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<QuestionFilter>, I>>(object: I): QuestionFilter {
+    const message = createBaseQuestionFilter();
+    message.subjectId = object.subjectId ?? 0;
+    message.tags = object.tags?.map((e) => e) || [];
+    message.matchAll = object.matchAll ?? false;
+    return message;
+  },
+};
+
+function createBaseQuestionTags(): QuestionTags {
+  return { questionId: 0, tags: [] };
+}
+
+export const QuestionTags = {
+  encode(message: QuestionTags, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.questionId !== 0) {
+      writer.uint32(8).int64(message.questionId);
+    }
+    for (const v of message.tags) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuestionTags {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuestionTags();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.questionId = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.tags.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QuestionTags {
+    return {
+      questionId: isSet(object.questionId) ? Number(object.questionId) : 0,
+      tags: Array.isArray(object?.tags) ? object.tags.map((e: any) => String(e)) : [],
+    };
+  },
+
+  toJSON(message: QuestionTags): unknown {
+    const obj: any = {};
+    if (message.questionId !== 0) {
+      obj.questionId = Math.round(message.questionId);
+    }
+    if (message.tags?.length) {
+      obj.tags = message.tags;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<QuestionTags>, I>>(base?: I): QuestionTags {
+    return QuestionTags.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<QuestionTags>, I>>(object: I): QuestionTags {
+    const message = createBaseQuestionTags();
+    message.questionId = object.questionId ?? 0;
+    message.tags = object.tags?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseNewTag(): NewTag {
+  return { subjectId: 0, name: "" };
+}
+
+export const NewTag = {
+  encode(message: NewTag, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.subjectId !== 0) {
+      writer.uint32(8).int64(message.subjectId);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): NewTag {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseNewTag();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.subjectId = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): NewTag {
+    return {
+      subjectId: isSet(object.subjectId) ? Number(object.subjectId) : 0,
+      name: isSet(object.name) ? String(object.name) : "",
+    };
+  },
+
+  toJSON(message: NewTag): unknown {
+    const obj: any = {};
+    if (message.subjectId !== 0) {
+      obj.subjectId = Math.round(message.subjectId);
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<NewTag>, I>>(base?: I): NewTag {
+    return NewTag.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<NewTag>, I>>(object: I): NewTag {
+    const message = createBaseNewTag();
+    message.subjectId = object.subjectId ?? 0;
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseTag(): Tag {
+  return { id: 0, subjectId: 0, name: "", questionCount: 0 };
+}
+
+export const Tag = {
+  encode(message: Tag, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.subjectId !== 0) {
+      writer.uint32(16).int64(message.subjectId);
+    }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.questionCount !== 0) {
+      writer.uint32(32).int64(message.questionCount);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Tag {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTag();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.subjectId = longToNumber(reader.int64() as Long);
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.questionCount = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Tag {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      subjectId: isSet(object.subjectId) ? Number(object.subjectId) : 0,
+      name: isSet(object.name) ? String(object.name) : "",
+      questionCount: isSet(object.questionCount) ? Number(object.questionCount) : 0,
+    };
+  },
+
+  toJSON(message: Tag): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.subjectId !== 0) {
+      obj.subjectId = Math.round(message.subjectId);
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.questionCount !== 0) {
+      obj.questionCount = Math.round(message.questionCount);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Tag>, I>>(base?: I): Tag {
+    return Tag.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<Tag>, I>>(object: I): Tag {
+    const message = createBaseTag();
+    message.id = object.id ?? 0;
+    message.subjectId = object.subjectId ?? 0;
+    message.name = object.name ?? "";
+    message.questionCount = object.questionCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseTagId(): TagId {
+  return { id: 0 };
+}
+
+export const TagId = {
+  encode(message: TagId, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TagId {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTagId();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TagId {
+    return { id: isSet(object.id) ? Number(object.id) : 0 };
+  },
+
+  toJSON(message: TagId): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TagId>, I>>(base?: I): TagId {
+    return TagId.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<TagId>, I>>(object: I): TagId {
+    const message = createBaseTagId();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseTagList(): TagList {
+  return { tagList: [] };
+}
+
+export const TagList = {
+  encode(message: TagList, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.tagList) {
+      Tag.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TagList {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTagList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.tagList.push(Tag.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TagList {
+    return { tagList: Array.isArray(object?.tagList) ? object.tagList.map((e: any) => Tag.fromJSON(e)) : [] };
+  },
+
+  toJSON(message: TagList): unknown {
+    const obj: any = {};
+    if (message.tagList?.length) {
+      obj.tagList = message.tagList.map((e) => Tag.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<TagList>, I>>(base?: I): TagList {
+    return TagList.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<TagList>, I>>(object: I): TagList {
+    const message = createBaseTagList();
+    message.tagList = object.tagList?.map((e) => Tag.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseQuestionQuery(): QuestionQuery {
+  return { query: "", subjectId: 0, createdFrom: undefined, createdTo: undefined, answered: undefined, limit: 0 };
+}
+
+export const QuestionQuery = {
+  encode(message: QuestionQuery, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.query !== "") {
+      writer.uint32(10).string(message.query);
+    }
+    if (message.subjectId !== 0) {
+      writer.uint32(16).int64(message.subjectId);
+    }
+    if (message.createdFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdFrom), writer.uint32(26).fork()).ldelim();
+    }
+    if (message.createdTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTo), writer.uint32(34).fork()).ldelim();
+    }
+    if (message.answered !== undefined) {
+      writer.uint32(40).bool(message.answered);
+    }
+    if (message.limit !== 0) {
+      writer.uint32(48).int32(message.limit);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuestionQuery {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuestionQuery();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.query = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.subjectId = longToNumber(reader.int64() as Long);
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.createdFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.createdTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.answered = reader.bool();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QuestionQuery {
+    return {
+      query: isSet(object.query) ? String(object.query) : "",
+      subjectId: isSet(object.subjectId) ? Number(object.subjectId) : 0,
+      createdFrom: isSet(object.createdFrom) ? fromJsonTimestamp(object.createdFrom) : undefined,
+      createdTo: isSet(object.createdTo) ? fromJsonTimestamp(object.createdTo) : undefined,
+      answered: isSet(object.answered) ? Boolean(object.answered) : undefined,
+      limit: isSet(object.limit) ? Number(object.limit) : 0,
+    };
+  },
+
+  toJSON(message: QuestionQuery): unknown {
+    const obj: any = {};
+    if (message.query !== "") {
+      obj.query = message.query;
+    }
+    if (message.subjectId !== 0) {
+      obj.subjectId = Math.round(message.subjectId);
+    }
+    if (message.createdFrom !== undefined) {
+      obj.createdFrom = message.createdFrom.toISOString();
+    }
+    if (message.createdTo !== undefined) {
+      obj.createdTo = message.createdTo.toISOString();
+    }
+    if (message.answered !== undefined) {
+      obj.answered = message.answered;
+    }
+    if (message.limit !== 0) {
+      obj.limit = Math.round(message.limit);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<QuestionQuery>, I>>(base?: I): QuestionQuery {
+    return QuestionQuery.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<QuestionQuery>, I>>(object: I): QuestionQuery {
+    const message = createBaseQuestionQuery();
+    message.query = object.query ?? "";
+    message.subjectId = object.subjectId ?? 0;
+    message.createdFrom = object.createdFrom ?? undefined;
+    message.createdTo = object.createdTo ?? undefined;
+    message.answered = object.answered ?? undefined;
+    message.limit = object.limit ?? 0;
+    return message;
+  },
+};
+
+function createBaseQuestionMatch(): QuestionMatch {
+  return { question: undefined, rank: 0, snippet: "" };
+}
+
+export const QuestionMatch = {
+  encode(message: QuestionMatch, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.question !== undefined) {
+      Question.encode(message.question, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.rank !== 0) {
+      writer.uint32(21).float(message.rank);
+    }
+    if (message.snippet !== "") {
+      writer.uint32(26).string(message.snippet);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuestionMatch {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuestionMatch();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.question = Question.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 21) {
+            break;
+          }
+
+          message.rank = reader.float();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.snippet = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QuestionMatch {
+    return {
+      question: isSet(object.question) ? Question.fromJSON(object.question) : undefined,
+      rank: isSet(object.rank) ? Number(object.rank) : 0,
+      snippet: isSet(object.snippet) ? String(object.snippet) : "",
+    };
+  },
+
+  toJSON(message: QuestionMatch): unknown {
+    const obj: any = {};
+    if (message.question !== undefined) {
+      obj.question = Question.toJSON(message.question);
+    }
+    if (message.rank !== 0) {
+      obj.rank = message.rank;
+    }
+    if (message.snippet !== "") {
+      obj.snippet = message.snippet;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<QuestionMatch>, I>>(base?: I): QuestionMatch {
+    return QuestionMatch.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<QuestionMatch>, I>>(object: I): QuestionMatch {
+    const message = createBaseQuestionMatch();
+    message.question = (object.question !== undefined && object.question !== null)
+      ? Question.fromPartial(object.question)
+      : undefined;
+    message.rank = object.rank ?? 0;
+    message.snippet = object.snippet ?? "";
+    return message;
+  },
+};
+
+function createBaseQuestionMatchList(): QuestionMatchList {
+  return { matchList: [] };
+}
+
+export const QuestionMatchList = {
+  encode(message: QuestionMatchList, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.matchList) {
+      QuestionMatch.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuestionMatchList {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuestionMatchList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.matchList.push(QuestionMatch.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QuestionMatchList {
+    return {
+      matchList: Array.isArray(object?.matchList) ? object.matchList.map((e: any) => QuestionMatch.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: QuestionMatchList): unknown {
+    const obj: any = {};
+    if (message.matchList?.length) {
+      obj.matchList = message.matchList.map((e) => QuestionMatch.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<QuestionMatchList>, I>>(base?: I): QuestionMatchList {
+    return QuestionMatchList.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<QuestionMatchList>, I>>(object: I): QuestionMatchList {
+    const message = createBaseQuestionMatchList();
+    message.matchList = object.matchList?.map((e) => QuestionMatch.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseQuestionEdit(): QuestionEdit {
+  return { id: 0, questionId: 0, action: "", question: "", editorId: "", editedAt: undefined };
+}
+
+export const QuestionEdit = {
+  encode(message: QuestionEdit, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.questionId !== 0) {
+      writer.uint32(16).int64(message.questionId);
+    }
+    if (message.action !== "") {
+      writer.uint32(26).string(message.action);
+    }
+    if (message.question !== "") {
+      writer.uint32(34).string(message.question);
+    }
+    if (message.editorId !== "") {
+      writer.uint32(42).string(message.editorId);
+    }
+    if (message.editedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.editedAt), writer.uint32(50).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuestionEdit {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuestionEdit();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.questionId = longToNumber(reader.int64() as Long);
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.action = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.question = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.editorId = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.editedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QuestionEdit {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      questionId: isSet(object.questionId) ? Number(object.questionId) : 0,
+      action: isSet(object.action) ? String(object.action) : "",
+      question: isSet(object.question) ? String(object.question) : "",
+      editorId: isSet(object.editorId) ? String(object.editorId) : "",
+      editedAt: isSet(object.editedAt) ? fromJsonTimestamp(object.editedAt) : undefined,
+    };
+  },
+
+  toJSON(message: QuestionEdit): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.questionId !== 0) {
+      obj.questionId = Math.round(message.questionId);
+    }
+    if (message.action !== "") {
+      obj.action = message.action;
+    }
+    if (message.question !== "") {
+      obj.question = message.question;
+    }
+    if (message.editorId !== "") {
+      obj.editorId = message.editorId;
+    }
+    if (message.editedAt !== undefined) {
+      obj.editedAt = message.editedAt.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<QuestionEdit>, I>>(base?: I): QuestionEdit {
+    return QuestionEdit.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<QuestionEdit>, I>>(object: I): QuestionEdit {
+    const message = createBaseQuestionEdit();
+    message.id = object.id ?? 0;
+    message.questionId = object.questionId ?? 0;
+    message.action = object.action ?? "";
+    message.question = object.question ?? "";
+    message.editorId = object.editorId ?? "";
+    message.editedAt = object.editedAt ?? undefined;
+    return message;
+  },
+};
+
+function createBaseQuestionEditList(): QuestionEditList {
+  return { editList: [] };
+}
+
+export const QuestionEditList = {
+  encode(message: QuestionEditList, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.editList) {
+      QuestionEdit.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuestionEditList {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuestionEditList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.editList.push(QuestionEdit.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QuestionEditList {
+    return {
+      editList: Array.isArray(object?.editList) ? object.editList.map((e: any) => QuestionEdit.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: QuestionEditList): unknown {
+    const obj: any = {};
+    if (message.editList?.length) {
+      obj.editList = message.editList.map((e) => QuestionEdit.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<QuestionEditList>, I>>(base?: I): QuestionEditList {
+    return QuestionEditList.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<QuestionEditList>, I>>(object: I): QuestionEditList {
+    const message = createBaseQuestionEditList();
+    message.editList = object.editList?.map((e) => QuestionEdit.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseQuestionList(): QuestionList {
+  return { questionList: [] };
+}
+
+export const QuestionList = {
+  encode(message: QuestionList, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.questionList) {
+      Question.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuestionList {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuestionList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.questionList.push(Question.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QuestionList {
+    return {
+      questionList: Array.isArray(object?.questionList)
+        ? object.questionList.map((e: any) => Question.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: QuestionList): unknown {
+    const obj: any = {};
+    if (message.questionList?.length) {
+      obj.questionList = message.questionList.map((e) => Question.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<QuestionList>, I>>(base?: I): QuestionList {
+    return QuestionList.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<QuestionList>, I>>(object: I): QuestionList {
     const message = createBaseQuestionList();
     message.questionList = object.questionList?.map((e) => Question.fromPartial(e)) || [];
     return message;
@@ -591,7 +2344,405 @@ export const SubjectList = {
             break;
           }
 
-          message.subjectList.push(Subject.decode(reader, reader.uint32()));
+          message.subjectList.push(Subject.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SubjectList {
+    return {
+      subjectList: Array.isArray(object?.subjectList) ? object.subjectList.map((e: any) => Subject.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: SubjectList): unknown {
+    const obj: any = {};
+    if (message.subjectList?.length) {
+      obj.subjectList = message.subjectList.map((e) => Subject.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<SubjectList>, I>>(base?: I): SubjectList {
+    return SubjectList.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<SubjectList>, I>>(object: I): SubjectList {
+    const message = createBaseSubjectList();
+    message.subjectList = object.subjectList?.map((e) => Subject.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseQuestionId(): QuestionId {
+  return { id: 0 };
+}
+
+export const QuestionId = {
+  encode(message: QuestionId, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuestionId {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuestionId();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QuestionId {
+    return { id: isSet(object.id) ? Number(object.id) : 0 };
+  },
+
+  toJSON(message: QuestionId): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<QuestionId>, I>>(base?: I): QuestionId {
+    return QuestionId.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<QuestionId>, I>>(object: I): QuestionId {
+    const message = createBaseQuestionId();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseAuditLogFilter(): AuditLogFilter {
+  return {
+    actorId: "",
+    method: "",
+    targetType: "",
+    targetId: 0,
+    requestId: "",
+    createdFrom: undefined,
+    createdTo: undefined,
+    beforeId: 0,
+    limit: 0,
+  };
+}
+
+export const AuditLogFilter = {
+  encode(message: AuditLogFilter, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.actorId !== "") {
+      writer.uint32(10).string(message.actorId);
+    }
+    if (message.method !== "") {
+      writer.uint32(18).string(message.method);
+    }
+    if (message.targetType !== "") {
+      writer.uint32(26).string(message.targetType);
+    }
+    if (message.targetId !== 0) {
+      writer.uint32(32).int64(message.targetId);
+    }
+    if (message.requestId !== "") {
+      writer.uint32(42).string(message.requestId);
+    }
+    if (message.createdFrom !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdFrom), writer.uint32(50).fork()).ldelim();
+    }
+    if (message.createdTo !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTo), writer.uint32(58).fork()).ldelim();
+    }
+    if (message.beforeId !== 0) {
+      writer.uint32(64).int64(message.beforeId);
+    }
+    if (message.limit !== 0) {
+      writer.uint32(72).int32(message.limit);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AuditLogFilter {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAuditLogFilter();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.actorId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.method = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.targetType = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.targetId = longToNumber(reader.int64() as Long);
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.requestId = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.createdFrom = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.createdTo = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.beforeId = longToNumber(reader.int64() as Long);
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AuditLogFilter {
+    return {
+      actorId: isSet(object.actorId) ? String(object.actorId) : "",
+      method: isSet(object.method) ? String(object.method) : "",
+      targetType: isSet(object.targetType) ? String(object.targetType) : "",
+      targetId: isSet(object.targetId) ? Number(object.targetId) : 0,
+      requestId: isSet(object.requestId) ? String(object.requestId) : "",
+      createdFrom: isSet(object.createdFrom) ? fromJsonTimestamp(object.createdFrom) : undefined,
+      createdTo: isSet(object.createdTo) ? fromJsonTimestamp(object.createdTo) : undefined,
+      beforeId: isSet(object.beforeId) ? Number(object.beforeId) : 0,
+      limit: isSet(object.limit) ? Number(object.limit) : 0,
+    };
+  },
+
+  toJSON(message: AuditLogFilter): unknown {
+    const obj: any = {};
+    if (message.actorId !== "") {
+      obj.actorId = message.actorId;
+    }
+    if (message.method !== "") {
+      obj.method = message.method;
+    }
+    if (message.targetType !== "") {
+      obj.targetType = message.targetType;
+    }
+    if (message.targetId !== 0) {
+      obj.targetId = Math.round(message.targetId);
+    }
+    if (message.requestId !== "") {
+      obj.requestId = message.requestId;
+    }
+    if (message.createdFrom !== undefined) {
+      obj.createdFrom = message.createdFrom.toISOString();
+    }
+    if (message.createdTo !== undefined) {
+      obj.createdTo = message.createdTo.toISOString();
+    }
+    if (message.beforeId !== 0) {
+      obj.beforeId = Math.round(message.beforeId);
+    }
+    if (message.limit !== 0) {
+      obj.limit = Math.round(message.limit);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AuditLogFilter>, I>>(base?: I): AuditLogFilter {
+    return AuditLogFilter.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<AuditLogFilter>, I>>(object: I): AuditLogFilter {
+    const message = createBaseAuditLogFilter();
+    message.actorId = object.actorId ?? "";
+    message.method = object.method ?? "";
+    message.targetType = object.targetType ?? "";
+    message.targetId = object.targetId ?? 0;
+    message.requestId = object.requestId ?? "";
+    message.createdFrom = object.createdFrom ?? undefined;
+    message.createdTo = object.createdTo ?? undefined;
+    message.beforeId = object.beforeId ?? 0;
+    message.limit = object.limit ?? 0;
+    return message;
+  },
+};
+
+function createBaseAuditLogEntry(): AuditLogEntry {
+  return {
+    id: 0,
+    actorId: "",
+    method: "",
+    targetType: "",
+    targetId: 0,
+    before: "",
+    after: "",
+    requestId: "",
+    createdAt: undefined,
+  };
+}
+
+export const AuditLogEntry = {
+  encode(message: AuditLogEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.actorId !== "") {
+      writer.uint32(18).string(message.actorId);
+    }
+    if (message.method !== "") {
+      writer.uint32(26).string(message.method);
+    }
+    if (message.targetType !== "") {
+      writer.uint32(34).string(message.targetType);
+    }
+    if (message.targetId !== 0) {
+      writer.uint32(40).int64(message.targetId);
+    }
+    if (message.before !== "") {
+      writer.uint32(50).string(message.before);
+    }
+    if (message.after !== "") {
+      writer.uint32(58).string(message.after);
+    }
+    if (message.requestId !== "") {
+      writer.uint32(66).string(message.requestId);
+    }
+    if (message.createdAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAt), writer.uint32(74).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AuditLogEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAuditLogEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.actorId = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.method = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.targetType = reader.string();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.targetId = longToNumber(reader.int64() as Long);
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.before = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.after = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.requestId = reader.string();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.createdAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -602,56 +2753,96 @@ export const SubjectList = {
     return message;
   },
 
-  fromJSON(object: any): SubjectList {
+  fromJSON(object: any): AuditLogEntry {
     return {
-      subjectList: Array.isArray(object?.subjectList) ? object.subjectList.map((e: any) => Subject.fromJSON(e)) : [],
+      id: isSet(object.id) ? Number(object.id) : 0,
+      actorId: isSet(object.actorId) ? String(object.actorId) : "",
+      method: isSet(object.method) ? String(object.method) : "",
+      targetType: isSet(object.targetType) ? String(object.targetType) : "",
+      targetId: isSet(object.targetId) ? Number(object.targetId) : 0,
+      before: isSet(object.before) ? String(object.before) : "",
+      after: isSet(object.after) ? String(object.after) : "",
+      requestId: isSet(object.requestId) ? String(object.requestId) : "",
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
     };
   },
 
-  toJSON(message: SubjectList): unknown {
+  toJSON(message: AuditLogEntry): unknown {
     const obj: any = {};
-    if (message.subjectList?.length) {
-      obj.subjectList = message.subjectList.map((e) => Subject.toJSON(e));
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.actorId !== "") {
+      obj.actorId = message.actorId;
+    }
+    if (message.method !== "") {
+      obj.method = message.method;
+    }
+    if (message.targetType !== "") {
+      obj.targetType = message.targetType;
+    }
+    if (message.targetId !== 0) {
+      obj.targetId = Math.round(message.targetId);
+    }
+    if (message.before !== "") {
+      obj.before = message.before;
+    }
+    if (message.after !== "") {
+      obj.after = message.after;
+    }
+    if (message.requestId !== "") {
+      obj.requestId = message.requestId;
+    }
+    if (message.createdAt !== undefined) {
+      obj.createdAt = message.createdAt.toISOString();
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<SubjectList>, I>>(base?: I): SubjectList {
-    return SubjectList.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<AuditLogEntry>, I>>(base?: I): AuditLogEntry {
+    return AuditLogEntry.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<SubjectList>, I>>(object: I): SubjectList {
-    const message = createBaseSubjectList();
-    message.subjectList = object.subjectList?.map((e) => Subject.fromPartial(e)) || [];
+  fromPartial<I extends Exact<DeepPartial<AuditLogEntry>, I>>(object: I): AuditLogEntry {
+    const message = createBaseAuditLogEntry();
+    message.id = object.id ?? 0;
+    message.actorId = object.actorId ?? "";
+    message.method = object.method ?? "";
+    message.targetType = object.targetType ?? "";
+    message.targetId = object.targetId ?? 0;
+    message.before = object.before ?? "";
+    message.after = object.after ?? "";
+    message.requestId = object.requestId ?? "";
+    message.createdAt = object.createdAt ?? undefined;
     return message;
   },
 };
 
-function createBaseQuestionId(): QuestionId {
-  return { id: 0 };
+function createBaseAuditLogList(): AuditLogList {
+  return { entryList: [] };
 }
 
-export const QuestionId = {
-  encode(message: QuestionId, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.id !== 0) {
-      writer.uint32(8).int64(message.id);
+export const AuditLogList = {
+  encode(message: AuditLogList, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.entryList) {
+      AuditLogEntry.encode(v!, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QuestionId {
+  decode(input: _m0.Reader | Uint8Array, length?: number): AuditLogList {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQuestionId();
+    const message = createBaseAuditLogList();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.id = longToNumber(reader.int64() as Long);
+          message.entryList.push(AuditLogEntry.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -662,25 +2853,27 @@ export const QuestionId = {
     return message;
   },
 
-  fromJSON(object: any): QuestionId {
-    return { id: isSet(object.id) ? Number(object.id) : 0 };
+  fromJSON(object: any): AuditLogList {
+    return {
+      entryList: Array.isArray(object?.entryList) ? object.entryList.map((e: any) => AuditLogEntry.fromJSON(e)) : [],
+    };
   },
 
-  toJSON(message: QuestionId): unknown {
+  toJSON(message: AuditLogList): unknown {
     const obj: any = {};
-    if (message.id !== 0) {
-      obj.id = Math.round(message.id);
+    if (message.entryList?.length) {
+      obj.entryList = message.entryList.map((e) => AuditLogEntry.toJSON(e));
     }
     return obj;
   },
 
-  create<I extends Exact<DeepPartial<QuestionId>, I>>(base?: I): QuestionId {
-    return QuestionId.fromPartial(base ?? {});
+  create<I extends Exact<DeepPartial<AuditLogList>, I>>(base?: I): AuditLogList {
+    return AuditLogList.fromPartial(base ?? {});
   },
 
-  fromPartial<I extends Exact<DeepPartial<QuestionId>, I>>(object: I): QuestionId {
-    const message = createBaseQuestionId();
-    message.id = object.id ?? 0;
+  fromPartial<I extends Exact<DeepPartial<AuditLogList>, I>>(object: I): AuditLogList {
+    const message = createBaseAuditLogList();
+    message.entryList = object.entryList?.map((e) => AuditLogEntry.fromPartial(e)) || [];
     return message;
   },
 };
@@ -705,12 +2898,66 @@ export const BoardService = {
     responseSerialize: (value: Subject) => Buffer.from(Subject.encode(value).finish()),
     responseDeserialize: (value: Buffer) => Subject.decode(value),
   },
-  listQuestions: {
-    path: "/board.Board/ListQuestions",
+  scheduleSubject: {
+    path: "/board.Board/ScheduleSubject",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: SubjectSchedule) => Buffer.from(SubjectSchedule.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => SubjectSchedule.decode(value),
+    responseSerialize: (value: Subject) => Buffer.from(Subject.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Subject.decode(value),
+  },
+  watchSubjects: {
+    path: "/board.Board/WatchSubjects",
+    requestStream: false,
+    responseStream: true,
+    requestSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => Empty.decode(value),
+    responseSerialize: (value: SubjectEvent) => Buffer.from(SubjectEvent.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => SubjectEvent.decode(value),
+  },
+  closeSubject: {
+    path: "/board.Board/CloseSubject",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: SubjectId) => Buffer.from(SubjectId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => SubjectId.decode(value),
+    responseSerialize: (value: Subject) => Buffer.from(Subject.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Subject.decode(value),
+  },
+  deleteSubject: {
+    path: "/board.Board/DeleteSubject",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: SubjectId) => Buffer.from(SubjectId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => SubjectId.decode(value),
+    responseSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Empty.decode(value),
+  },
+  restoreSubject: {
+    path: "/board.Board/RestoreSubject",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: SubjectId) => Buffer.from(SubjectId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => SubjectId.decode(value),
+    responseSerialize: (value: Subject) => Buffer.from(Subject.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Subject.decode(value),
+  },
+  getFinalRanking: {
+    path: "/board.Board/GetFinalRanking",
     requestStream: false,
     responseStream: false,
     requestSerialize: (value: SubjectId) => Buffer.from(SubjectId.encode(value).finish()),
     requestDeserialize: (value: Buffer) => SubjectId.decode(value),
+    responseSerialize: (value: Ranking) => Buffer.from(Ranking.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Ranking.decode(value),
+  },
+  listQuestions: {
+    path: "/board.Board/ListQuestions",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: QuestionFilter) => Buffer.from(QuestionFilter.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => QuestionFilter.decode(value),
     responseSerialize: (value: QuestionList) => Buffer.from(QuestionList.encode(value).finish()),
     responseDeserialize: (value: Buffer) => QuestionList.decode(value),
   },
@@ -720,6 +2967,87 @@ export const BoardService = {
     responseStream: false,
     requestSerialize: (value: NewQuestion) => Buffer.from(NewQuestion.encode(value).finish()),
     requestDeserialize: (value: Buffer) => NewQuestion.decode(value),
+    responseSerialize: (value: Question) => Buffer.from(Question.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Question.decode(value),
+  },
+  updateQuestion: {
+    path: "/board.Board/UpdateQuestion",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: EditedQuestion) => Buffer.from(EditedQuestion.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => EditedQuestion.decode(value),
+    responseSerialize: (value: Question) => Buffer.from(Question.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Question.decode(value),
+  },
+  deleteQuestion: {
+    path: "/board.Board/DeleteQuestion",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: QuestionId) => Buffer.from(QuestionId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => QuestionId.decode(value),
+    responseSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Empty.decode(value),
+  },
+  restoreQuestion: {
+    path: "/board.Board/RestoreQuestion",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: QuestionId) => Buffer.from(QuestionId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => QuestionId.decode(value),
+    responseSerialize: (value: Question) => Buffer.from(Question.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Question.decode(value),
+  },
+  listQuestionEdits: {
+    path: "/board.Board/ListQuestionEdits",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: QuestionId) => Buffer.from(QuestionId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => QuestionId.decode(value),
+    responseSerialize: (value: QuestionEditList) => Buffer.from(QuestionEditList.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => QuestionEditList.decode(value),
+  },
+  searchQuestions: {
+    path: "/board.Board/SearchQuestions",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: QuestionQuery) => Buffer.from(QuestionQuery.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => QuestionQuery.decode(value),
+    responseSerialize: (value: QuestionMatchList) => Buffer.from(QuestionMatchList.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => QuestionMatchList.decode(value),
+  },
+  setQuestionTags: {
+    path: "/board.Board/SetQuestionTags",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: QuestionTags) => Buffer.from(QuestionTags.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => QuestionTags.decode(value),
+    responseSerialize: (value: Question) => Buffer.from(Question.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Question.decode(value),
+  },
+  listTags: {
+    path: "/board.Board/ListTags",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: SubjectId) => Buffer.from(SubjectId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => SubjectId.decode(value),
+    responseSerialize: (value: TagList) => Buffer.from(TagList.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => TagList.decode(value),
+  },
+  createTag: {
+    path: "/board.Board/CreateTag",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: NewTag) => Buffer.from(NewTag.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => NewTag.decode(value),
+    responseSerialize: (value: Tag) => Buffer.from(Tag.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Tag.decode(value),
+  },
+  deleteTag: {
+    path: "/board.Board/DeleteTag",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: TagId) => Buffer.from(TagId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => TagId.decode(value),
     responseSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
     responseDeserialize: (value: Buffer) => Empty.decode(value),
   },
@@ -741,15 +3069,40 @@ export const BoardService = {
     responseSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
     responseDeserialize: (value: Buffer) => Empty.decode(value),
   },
+  listAuditLog: {
+    path: "/board.Board/ListAuditLog",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: AuditLogFilter) => Buffer.from(AuditLogFilter.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => AuditLogFilter.decode(value),
+    responseSerialize: (value: AuditLogList) => Buffer.from(AuditLogList.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => AuditLogList.decode(value),
+  },
 } as const;
 
 export interface BoardServer extends UntypedServiceImplementation {
   listSubjects: handleUnaryCall<Empty, SubjectList>;
   getSubject: handleUnaryCall<SubjectId, Subject>;
-  listQuestions: handleUnaryCall<SubjectId, QuestionList>;
-  createQuestion: handleUnaryCall<NewQuestion, Empty>;
+  scheduleSubject: handleUnaryCall<SubjectSchedule, Subject>;
+  watchSubjects: handleServerStreamingCall<Empty, SubjectEvent>;
+  closeSubject: handleUnaryCall<SubjectId, Subject>;
+  deleteSubject: handleUnaryCall<SubjectId, Empty>;
+  restoreSubject: handleUnaryCall<SubjectId, Subject>;
+  getFinalRanking: handleUnaryCall<SubjectId, Ranking>;
+  listQuestions: handleUnaryCall<QuestionFilter, QuestionList>;
+  createQuestion: handleUnaryCall<NewQuestion, Question>;
+  updateQuestion: handleUnaryCall<EditedQuestion, Question>;
+  deleteQuestion: handleUnaryCall<QuestionId, Empty>;
+  restoreQuestion: handleUnaryCall<QuestionId, Question>;
+  listQuestionEdits: handleUnaryCall<QuestionId, QuestionEditList>;
+  searchQuestions: handleUnaryCall<QuestionQuery, QuestionMatchList>;
+  setQuestionTags: handleUnaryCall<QuestionTags, Question>;
+  listTags: handleUnaryCall<SubjectId, TagList>;
+  createTag: handleUnaryCall<NewTag, Tag>;
+  deleteTag: handleUnaryCall<TagId, Empty>;
   like: handleUnaryCall<QuestionId, Empty>;
   unlike: handleUnaryCall<QuestionId, Empty>;
+  listAuditLog: handleUnaryCall<AuditLogFilter, AuditLogList>;
 }
 
 export interface BoardClient extends Client {
@@ -777,34 +3130,232 @@ export interface BoardClient extends Client {
     options: Partial<CallOptions>,
     callback: (error: ServiceError | null, response: Subject) => void,
   ): ClientUnaryCall;
-  listQuestions(
+  scheduleSubject(
+    request: SubjectSchedule,
+    callback: (error: ServiceError | null, response: Subject) => void,
+  ): ClientUnaryCall;
+  scheduleSubject(
+    request: SubjectSchedule,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Subject) => void,
+  ): ClientUnaryCall;
+  scheduleSubject(
+    request: SubjectSchedule,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Subject) => void,
+  ): ClientUnaryCall;
+  watchSubjects(request: Empty, options?: Partial<CallOptions>): ClientReadableStream<SubjectEvent>;
+  watchSubjects(
+    request: Empty,
+    metadata?: Metadata,
+    options?: Partial<CallOptions>,
+  ): ClientReadableStream<SubjectEvent>;
+  closeSubject(request: SubjectId, callback: (error: ServiceError | null, response: Subject) => void): ClientUnaryCall;
+  closeSubject(
+    request: SubjectId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Subject) => void,
+  ): ClientUnaryCall;
+  closeSubject(
+    request: SubjectId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Subject) => void,
+  ): ClientUnaryCall;
+  deleteSubject(request: SubjectId, callback: (error: ServiceError | null, response: Empty) => void): ClientUnaryCall;
+  deleteSubject(
+    request: SubjectId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Empty) => void,
+  ): ClientUnaryCall;
+  deleteSubject(
+    request: SubjectId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Empty) => void,
+  ): ClientUnaryCall;
+  restoreSubject(
+    request: SubjectId,
+    callback: (error: ServiceError | null, response: Subject) => void,
+  ): ClientUnaryCall;
+  restoreSubject(
+    request: SubjectId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Subject) => void,
+  ): ClientUnaryCall;
+  restoreSubject(
+    request: SubjectId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Subject) => void,
+  ): ClientUnaryCall;
+  getFinalRanking(
+    request: SubjectId,
+    callback: (error: ServiceError | null, response: Ranking) => void,
+  ): ClientUnaryCall;
+  getFinalRanking(
+    request: SubjectId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Ranking) => void,
+  ): ClientUnaryCall;
+  getFinalRanking(
     request: SubjectId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Ranking) => void,
+  ): ClientUnaryCall;
+  listQuestions(
+    request: QuestionFilter,
     callback: (error: ServiceError | null, response: QuestionList) => void,
   ): ClientUnaryCall;
   listQuestions(
-    request: SubjectId,
+    request: QuestionFilter,
     metadata: Metadata,
     callback: (error: ServiceError | null, response: QuestionList) => void,
   ): ClientUnaryCall;
   listQuestions(
-    request: SubjectId,
+    request: QuestionFilter,
     metadata: Metadata,
     options: Partial<CallOptions>,
     callback: (error: ServiceError | null, response: QuestionList) => void,
   ): ClientUnaryCall;
   createQuestion(
     request: NewQuestion,
-    callback: (error: ServiceError | null, response: Empty) => void,
+    callback: (error: ServiceError | null, response: Question) => void,
   ): ClientUnaryCall;
   createQuestion(
     request: NewQuestion,
     metadata: Metadata,
-    callback: (error: ServiceError | null, response: Empty) => void,
+    callback: (error: ServiceError | null, response: Question) => void,
   ): ClientUnaryCall;
   createQuestion(
     request: NewQuestion,
     metadata: Metadata,
     options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Question) => void,
+  ): ClientUnaryCall;
+  updateQuestion(
+    request: EditedQuestion,
+    callback: (error: ServiceError | null, response: Question) => void,
+  ): ClientUnaryCall;
+  updateQuestion(
+    request: EditedQuestion,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Question) => void,
+  ): ClientUnaryCall;
+  updateQuestion(
+    request: EditedQuestion,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Question) => void,
+  ): ClientUnaryCall;
+  deleteQuestion(request: QuestionId, callback: (error: ServiceError | null, response: Empty) => void): ClientUnaryCall;
+  deleteQuestion(
+    request: QuestionId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Empty) => void,
+  ): ClientUnaryCall;
+  deleteQuestion(
+    request: QuestionId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Empty) => void,
+  ): ClientUnaryCall;
+  restoreQuestion(
+    request: QuestionId,
+    callback: (error: ServiceError | null, response: Question) => void,
+  ): ClientUnaryCall;
+  restoreQuestion(
+    request: QuestionId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Question) => void,
+  ): ClientUnaryCall;
+  restoreQuestion(
+    request: QuestionId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Question) => void,
+  ): ClientUnaryCall;
+  listQuestionEdits(
+    request: QuestionId,
+    callback: (error: ServiceError | null, response: QuestionEditList) => void,
+  ): ClientUnaryCall;
+  listQuestionEdits(
+    request: QuestionId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: QuestionEditList) => void,
+  ): ClientUnaryCall;
+  listQuestionEdits(
+    request: QuestionId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: QuestionEditList) => void,
+  ): ClientUnaryCall;
+  searchQuestions(
+    request: QuestionQuery,
+    callback: (error: ServiceError | null, response: QuestionMatchList) => void,
+  ): ClientUnaryCall;
+  searchQuestions(
+    request: QuestionQuery,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: QuestionMatchList) => void,
+  ): ClientUnaryCall;
+  searchQuestions(
+    request: QuestionQuery,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: QuestionMatchList) => void,
+  ): ClientUnaryCall;
+  setQuestionTags(
+    request: QuestionTags,
+    callback: (error: ServiceError | null, response: Question) => void,
+  ): ClientUnaryCall;
+  setQuestionTags(
+    request: QuestionTags,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Question) => void,
+  ): ClientUnaryCall;
+  setQuestionTags(
+    request: QuestionTags,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Question) => void,
+  ): ClientUnaryCall;
+  listTags(request: SubjectId, callback: (error: ServiceError | null, response: TagList) => void): ClientUnaryCall;
+  listTags(
+    request: SubjectId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: TagList) => void,
+  ): ClientUnaryCall;
+  listTags(
+    request: SubjectId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: TagList) => void,
+  ): ClientUnaryCall;
+  createTag(request: NewTag, callback: (error: ServiceError | null, response: Tag) => void): ClientUnaryCall;
+  createTag(
+    request: NewTag,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Tag) => void,
+  ): ClientUnaryCall;
+  createTag(
+    request: NewTag,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Tag) => void,
+  ): ClientUnaryCall;
+  deleteTag(request: TagId, callback: (error: ServiceError | null, response: Empty) => void): ClientUnaryCall;
+  deleteTag(
+    request: TagId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Empty) => void,
+  ): ClientUnaryCall;
+  deleteTag(
+    request: TagId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
     callback: (error: ServiceError | null, response: Empty) => void,
   ): ClientUnaryCall;
   like(request: QuestionId, callback: (error: ServiceError | null, response: Empty) => void): ClientUnaryCall;
@@ -831,6 +3382,21 @@ export interface BoardClient extends Client {
     options: Partial<CallOptions>,
     callback: (error: ServiceError | null, response: Empty) => void,
   ): ClientUnaryCall;
+  listAuditLog(
+    request: AuditLogFilter,
+    callback: (error: ServiceError | null, response: AuditLogList) => void,
+  ): ClientUnaryCall;
+  listAuditLog(
+    request: AuditLogFilter,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: AuditLogList) => void,
+  ): ClientUnaryCall;
+  listAuditLog(
+    request: AuditLogFilter,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: AuditLogList) => void,
+  ): ClientUnaryCall;
 }
 
 export const BoardClient = makeGenericClientConstructor(BoardService, "board.Board") as unknown as {
@@ -868,6 +3434,28 @@ type KeysOfUnion<T> = T extends T ? keyof T : never;
 export type Exact<P, I extends P> = P extends Builtin ? P
   : P & { [K in keyof P]: Exact<P[K], I[K]> } & { [K in Exclude<keyof I, KeysOfUnion<P>>]: never };
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = Math.trunc(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
 function longToNumber(long: Long): number {
   if (long.gt(Number.MAX_SAFE_INTEGER)) {
     throw new tsProtoGlobalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
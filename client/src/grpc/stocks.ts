@@ -0,0 +1,3003 @@
+/* eslint-disable */
+import {
+  CallOptions,
+  ChannelCredentials,
+  Client,
+  ClientOptions,
+  ClientReadableStream,
+  ClientUnaryCall,
+  handleServerStreamingCall,
+  handleUnaryCall,
+  makeGenericClientConstructor,
+  Metadata,
+  ServiceError,
+  UntypedServiceImplementation,
+} from "@grpc/grpc-js";
+import Long from "long";
+import _m0 from "protobufjs/minimal";
+import { Empty } from "./google/protobuf/empty";
+import { Timestamp } from "./google/protobuf/timestamp";
+
+export const protobufPackage = "stocks";
+
+export enum CorporateActionType {
+  CORPORATE_ACTION_TYPE_UNSPECIFIED = 0,
+  SPLIT = 1,
+  REVERSE_SPLIT = 2,
+  ISSUANCE = 3,
+  BUYBACK = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function corporateActionTypeFromJSON(object: any): CorporateActionType {
+  switch (object) {
+    case 0:
+    case "CORPORATE_ACTION_TYPE_UNSPECIFIED":
+      return CorporateActionType.CORPORATE_ACTION_TYPE_UNSPECIFIED;
+    case 1:
+    case "SPLIT":
+      return CorporateActionType.SPLIT;
+    case 2:
+    case "REVERSE_SPLIT":
+      return CorporateActionType.REVERSE_SPLIT;
+    case 3:
+    case "ISSUANCE":
+      return CorporateActionType.ISSUANCE;
+    case 4:
+    case "BUYBACK":
+      return CorporateActionType.BUYBACK;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return CorporateActionType.UNRECOGNIZED;
+  }
+}
+
+export function corporateActionTypeToJSON(object: CorporateActionType): string {
+  switch (object) {
+    case CorporateActionType.CORPORATE_ACTION_TYPE_UNSPECIFIED:
+      return "CORPORATE_ACTION_TYPE_UNSPECIFIED";
+    case CorporateActionType.SPLIT:
+      return "SPLIT";
+    case CorporateActionType.REVERSE_SPLIT:
+      return "REVERSE_SPLIT";
+    case CorporateActionType.ISSUANCE:
+      return "ISSUANCE";
+    case CorporateActionType.BUYBACK:
+      return "BUYBACK";
+    case CorporateActionType.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export interface Stock {
+  id: number;
+  code: string;
+  name: string;
+  totalStockCount: number;
+}
+
+export interface StockCode {
+  code: string;
+}
+
+export interface StockId {
+  id: number;
+}
+
+export interface StockFilter {
+  query: string;
+  codes: string[];
+  pageSize: number;
+  pageToken: string;
+}
+
+export interface StockList {
+  stockList: Stock[];
+  nextPageToken: string;
+}
+
+export interface QuoteRequest {
+  codes: string[];
+}
+
+export interface Quote {
+  code: string;
+  bid: number;
+  ask: number;
+  last: number;
+  volume: number;
+  time: Date | undefined;
+}
+
+export interface CandleRequest {
+  code: string;
+  interval: string;
+  from: Date | undefined;
+  to: Date | undefined;
+}
+
+export interface Candle {
+  code: string;
+  interval: string;
+  start: Date | undefined;
+  open: number;
+  high: number;
+  low: number;
+  close: number;
+  volume: number;
+}
+
+export interface CandleList {
+  candleList: Candle[];
+}
+
+export interface StockImport {
+  format: string;
+  data: Buffer;
+}
+
+export interface StockImportError {
+  row: number;
+  code: string;
+  message: string;
+}
+
+export interface StockImportResult {
+  imported: number;
+  errors: StockImportError[];
+}
+
+export interface StockExportRequest {
+  format: string;
+}
+
+export interface StockExport {
+  format: string;
+  data: Buffer;
+}
+
+export interface NewCorporateAction {
+  code: string;
+  type: CorporateActionType;
+  oldShares: number;
+  newShares: number;
+  shares: number;
+  effectiveAt: Date | undefined;
+}
+
+export interface CorporateAction {
+  id: number;
+  code: string;
+  type: CorporateActionType;
+  oldShares: number;
+  newShares: number;
+  shares: number;
+  effectiveAt: Date | undefined;
+  appliedAt: Date | undefined;
+  sharesBefore: number;
+  sharesAfter: number;
+}
+
+export interface CorporateActionList {
+  corporateActionList: CorporateAction[];
+}
+
+export interface ShareCountRequest {
+  code: string;
+  asOf: Date | undefined;
+}
+
+export interface ShareCount {
+  code: string;
+  asOf: Date | undefined;
+  totalStockCount: number;
+}
+
+export interface NewWatchlist {
+  name: string;
+  codes: string[];
+}
+
+export interface Watchlist {
+  id: number;
+  name: string;
+  codes: string[];
+  createdAt: Date | undefined;
+}
+
+export interface WatchlistId {
+  id: number;
+}
+
+export interface WatchlistList {
+  watchlistList: Watchlist[];
+}
+
+export interface WatchlistUpdate {
+  id: number;
+  name: string;
+  codes: string[];
+}
+
+function createBaseStock(): Stock {
+  return { id: 0, code: "", name: "", totalStockCount: 0 };
+}
+
+export const Stock = {
+  encode(message: Stock, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.code !== "") {
+      writer.uint32(18).string(message.code);
+    }
+    if (message.name !== "") {
+      writer.uint32(26).string(message.name);
+    }
+    if (message.totalStockCount !== 0) {
+      writer.uint32(32).int64(message.totalStockCount);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Stock {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStock();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.totalStockCount = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Stock {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      code: isSet(object.code) ? String(object.code) : "",
+      name: isSet(object.name) ? String(object.name) : "",
+      totalStockCount: isSet(object.totalStockCount) ? Number(object.totalStockCount) : 0,
+    };
+  },
+
+  toJSON(message: Stock): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.totalStockCount !== 0) {
+      obj.totalStockCount = Math.round(message.totalStockCount);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Stock>, I>>(base?: I): Stock {
+    return Stock.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<Stock>, I>>(object: I): Stock {
+    const message = createBaseStock();
+    message.id = object.id ?? 0;
+    message.code = object.code ?? "";
+    message.name = object.name ?? "";
+    message.totalStockCount = object.totalStockCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseStockCode(): StockCode {
+  return { code: "" };
+}
+
+export const StockCode = {
+  encode(message: StockCode, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StockCode {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStockCode();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StockCode {
+    return { code: isSet(object.code) ? String(object.code) : "" };
+  },
+
+  toJSON(message: StockCode): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<StockCode>, I>>(base?: I): StockCode {
+    return StockCode.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<StockCode>, I>>(object: I): StockCode {
+    const message = createBaseStockCode();
+    message.code = object.code ?? "";
+    return message;
+  },
+};
+
+function createBaseStockId(): StockId {
+  return { id: 0 };
+}
+
+export const StockId = {
+  encode(message: StockId, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StockId {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStockId();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StockId {
+    return { id: isSet(object.id) ? Number(object.id) : 0 };
+  },
+
+  toJSON(message: StockId): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<StockId>, I>>(base?: I): StockId {
+    return StockId.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<StockId>, I>>(object: I): StockId {
+    const message = createBaseStockId();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseStockFilter(): StockFilter {
+  return { query: "", codes: [], pageSize: 0, pageToken: "" };
+}
+
+export const StockFilter = {
+  encode(message: StockFilter, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.query !== "") {
+      writer.uint32(10).string(message.query);
+    }
+    for (const v of message.codes) {
+      writer.uint32(18).string(v!);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(24).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(34).string(message.pageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StockFilter {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStockFilter();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.query = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.codes.push(reader.string());
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StockFilter {
+    return {
+      query: isSet(object.query) ? String(object.query) : "",
+      codes: Array.isArray(object?.codes) ? object.codes.map((e: any) => String(e)) : [],
+      pageSize: isSet(object.pageSize) ? Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? String(object.pageToken) : "",
+    };
+  },
+
+  toJSON(message: StockFilter): unknown {
+    const obj: any = {};
+    if (message.query !== "") {
+      obj.query = message.query;
+    }
+    if (message.codes?.length) {
+      obj.codes = message.codes;
+    }
+    if (message.pageSize !== 0) {
+      obj.pageSize = Math.round(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      obj.pageToken = message.pageToken;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<StockFilter>, I>>(base?: I): StockFilter {
+    return StockFilter.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<StockFilter>, I>>(object: I): StockFilter {
+    const message = createBaseStockFilter();
+    message.query = object.query ?? "";
+    message.codes = object.codes?.map((e) => e) || [];
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseStockList(): StockList {
+  return { stockList: [], nextPageToken: "" };
+}
+
+export const StockList = {
+  encode(message: StockList, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.stockList) {
+      Stock.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StockList {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStockList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.stockList.push(Stock.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StockList {
+    return {
+      stockList: Array.isArray(object?.stockList) ? object.stockList.map((e: any) => Stock.fromJSON(e)) : [],
+      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : "",
+    };
+  },
+
+  toJSON(message: StockList): unknown {
+    const obj: any = {};
+    if (message.stockList?.length) {
+      obj.stockList = message.stockList.map((e) => Stock.toJSON(e));
+    }
+    if (message.nextPageToken !== "") {
+      obj.nextPageToken = message.nextPageToken;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<StockList>, I>>(base?: I): StockList {
+    return StockList.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<StockList>, I>>(object: I): StockList {
+    const message = createBaseStockList();
+    message.stockList = object.stockList?.map((e) => Stock.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseQuoteRequest(): QuoteRequest {
+  return { codes: [] };
+}
+
+export const QuoteRequest = {
+  encode(message: QuoteRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.codes) {
+      writer.uint32(10).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QuoteRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuoteRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.codes.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QuoteRequest {
+    return { codes: Array.isArray(object?.codes) ? object.codes.map((e: any) => String(e)) : [] };
+  },
+
+  toJSON(message: QuoteRequest): unknown {
+    const obj: any = {};
+    if (message.codes?.length) {
+      obj.codes = message.codes;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<QuoteRequest>, I>>(base?: I): QuoteRequest {
+    return QuoteRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<QuoteRequest>, I>>(object: I): QuoteRequest {
+    const message = createBaseQuoteRequest();
+    message.codes = object.codes?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseQuote(): Quote {
+  return { code: "", bid: 0, ask: 0, last: 0, volume: 0, time: undefined };
+}
+
+export const Quote = {
+  encode(message: Quote, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    if (message.bid !== 0) {
+      writer.uint32(17).double(message.bid);
+    }
+    if (message.ask !== 0) {
+      writer.uint32(25).double(message.ask);
+    }
+    if (message.last !== 0) {
+      writer.uint32(33).double(message.last);
+    }
+    if (message.volume !== 0) {
+      writer.uint32(40).int64(message.volume);
+    }
+    if (message.time !== undefined) {
+      Timestamp.encode(toTimestamp(message.time), writer.uint32(50).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Quote {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQuote();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 2:
+          if (tag !== 17) {
+            break;
+          }
+
+          message.bid = reader.double();
+          continue;
+        case 3:
+          if (tag !== 25) {
+            break;
+          }
+
+          message.ask = reader.double();
+          continue;
+        case 4:
+          if (tag !== 33) {
+            break;
+          }
+
+          message.last = reader.double();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.volume = longToNumber(reader.int64() as Long);
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Quote {
+    return {
+      code: isSet(object.code) ? String(object.code) : "",
+      bid: isSet(object.bid) ? Number(object.bid) : 0,
+      ask: isSet(object.ask) ? Number(object.ask) : 0,
+      last: isSet(object.last) ? Number(object.last) : 0,
+      volume: isSet(object.volume) ? Number(object.volume) : 0,
+      time: isSet(object.time) ? fromJsonTimestamp(object.time) : undefined,
+    };
+  },
+
+  toJSON(message: Quote): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.bid !== 0) {
+      obj.bid = message.bid;
+    }
+    if (message.ask !== 0) {
+      obj.ask = message.ask;
+    }
+    if (message.last !== 0) {
+      obj.last = message.last;
+    }
+    if (message.volume !== 0) {
+      obj.volume = Math.round(message.volume);
+    }
+    if (message.time !== undefined) {
+      obj.time = message.time.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Quote>, I>>(base?: I): Quote {
+    return Quote.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<Quote>, I>>(object: I): Quote {
+    const message = createBaseQuote();
+    message.code = object.code ?? "";
+    message.bid = object.bid ?? 0;
+    message.ask = object.ask ?? 0;
+    message.last = object.last ?? 0;
+    message.volume = object.volume ?? 0;
+    message.time = object.time ?? undefined;
+    return message;
+  },
+};
+
+function createBaseCandleRequest(): CandleRequest {
+  return { code: "", interval: "", from: undefined, to: undefined };
+}
+
+export const CandleRequest = {
+  encode(message: CandleRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    if (message.interval !== "") {
+      writer.uint32(18).string(message.interval);
+    }
+    if (message.from !== undefined) {
+      Timestamp.encode(toTimestamp(message.from), writer.uint32(26).fork()).ldelim();
+    }
+    if (message.to !== undefined) {
+      Timestamp.encode(toTimestamp(message.to), writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CandleRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCandleRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.interval = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.from = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.to = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CandleRequest {
+    return {
+      code: isSet(object.code) ? String(object.code) : "",
+      interval: isSet(object.interval) ? String(object.interval) : "",
+      from: isSet(object.from) ? fromJsonTimestamp(object.from) : undefined,
+      to: isSet(object.to) ? fromJsonTimestamp(object.to) : undefined,
+    };
+  },
+
+  toJSON(message: CandleRequest): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.interval !== "") {
+      obj.interval = message.interval;
+    }
+    if (message.from !== undefined) {
+      obj.from = message.from.toISOString();
+    }
+    if (message.to !== undefined) {
+      obj.to = message.to.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CandleRequest>, I>>(base?: I): CandleRequest {
+    return CandleRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CandleRequest>, I>>(object: I): CandleRequest {
+    const message = createBaseCandleRequest();
+    message.code = object.code ?? "";
+    message.interval = object.interval ?? "";
+    message.from = object.from ?? undefined;
+    message.to = object.to ?? undefined;
+    return message;
+  },
+};
+
+function createBaseCandle(): Candle {
+  return { code: "", interval: "", start: undefined, open: 0, high: 0, low: 0, close: 0, volume: 0 };
+}
+
+export const Candle = {
+  encode(message: Candle, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    if (message.interval !== "") {
+      writer.uint32(18).string(message.interval);
+    }
+    if (message.start !== undefined) {
+      Timestamp.encode(toTimestamp(message.start), writer.uint32(26).fork()).ldelim();
+    }
+    if (message.open !== 0) {
+      writer.uint32(33).double(message.open);
+    }
+    if (message.high !== 0) {
+      writer.uint32(41).double(message.high);
+    }
+    if (message.low !== 0) {
+      writer.uint32(49).double(message.low);
+    }
+    if (message.close !== 0) {
+      writer.uint32(57).double(message.close);
+    }
+    if (message.volume !== 0) {
+      writer.uint32(64).int64(message.volume);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Candle {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCandle();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.interval = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.start = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 33) {
+            break;
+          }
+
+          message.open = reader.double();
+          continue;
+        case 5:
+          if (tag !== 41) {
+            break;
+          }
+
+          message.high = reader.double();
+          continue;
+        case 6:
+          if (tag !== 49) {
+            break;
+          }
+
+          message.low = reader.double();
+          continue;
+        case 7:
+          if (tag !== 57) {
+            break;
+          }
+
+          message.close = reader.double();
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.volume = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Candle {
+    return {
+      code: isSet(object.code) ? String(object.code) : "",
+      interval: isSet(object.interval) ? String(object.interval) : "",
+      start: isSet(object.start) ? fromJsonTimestamp(object.start) : undefined,
+      open: isSet(object.open) ? Number(object.open) : 0,
+      high: isSet(object.high) ? Number(object.high) : 0,
+      low: isSet(object.low) ? Number(object.low) : 0,
+      close: isSet(object.close) ? Number(object.close) : 0,
+      volume: isSet(object.volume) ? Number(object.volume) : 0,
+    };
+  },
+
+  toJSON(message: Candle): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.interval !== "") {
+      obj.interval = message.interval;
+    }
+    if (message.start !== undefined) {
+      obj.start = message.start.toISOString();
+    }
+    if (message.open !== 0) {
+      obj.open = message.open;
+    }
+    if (message.high !== 0) {
+      obj.high = message.high;
+    }
+    if (message.low !== 0) {
+      obj.low = message.low;
+    }
+    if (message.close !== 0) {
+      obj.close = message.close;
+    }
+    if (message.volume !== 0) {
+      obj.volume = Math.round(message.volume);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Candle>, I>>(base?: I): Candle {
+    return Candle.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<Candle>, I>>(object: I): Candle {
+    const message = createBaseCandle();
+    message.code = object.code ?? "";
+    message.interval = object.interval ?? "";
+    message.start = object.start ?? undefined;
+    message.open = object.open ?? 0;
+    message.high = object.high ?? 0;
+    message.low = object.low ?? 0;
+    message.close = object.close ?? 0;
+    message.volume = object.volume ?? 0;
+    return message;
+  },
+};
+
+function createBaseCandleList(): CandleList {
+  return { candleList: [] };
+}
+
+export const CandleList = {
+  encode(message: CandleList, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.candleList) {
+      Candle.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CandleList {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCandleList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.candleList.push(Candle.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CandleList {
+    return {
+      candleList: Array.isArray(object?.candleList) ? object.candleList.map((e: any) => Candle.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: CandleList): unknown {
+    const obj: any = {};
+    if (message.candleList?.length) {
+      obj.candleList = message.candleList.map((e) => Candle.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CandleList>, I>>(base?: I): CandleList {
+    return CandleList.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CandleList>, I>>(object: I): CandleList {
+    const message = createBaseCandleList();
+    message.candleList = object.candleList?.map((e) => Candle.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseStockImport(): StockImport {
+  return { format: "", data: Buffer.alloc(0) };
+}
+
+export const StockImport = {
+  encode(message: StockImport, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.format !== "") {
+      writer.uint32(10).string(message.format);
+    }
+    if (message.data.length !== 0) {
+      writer.uint32(18).bytes(message.data);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StockImport {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStockImport();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.format = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.data = reader.bytes() as Buffer;
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StockImport {
+    return {
+      format: isSet(object.format) ? String(object.format) : "",
+      data: isSet(object.data) ? Buffer.from(bytesFromBase64(object.data)) : Buffer.alloc(0),
+    };
+  },
+
+  toJSON(message: StockImport): unknown {
+    const obj: any = {};
+    if (message.format !== "") {
+      obj.format = message.format;
+    }
+    if (message.data.length !== 0) {
+      obj.data = base64FromBytes(message.data);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<StockImport>, I>>(base?: I): StockImport {
+    return StockImport.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<StockImport>, I>>(object: I): StockImport {
+    const message = createBaseStockImport();
+    message.format = object.format ?? "";
+    message.data = object.data ?? Buffer.alloc(0);
+    return message;
+  },
+};
+
+function createBaseStockImportError(): StockImportError {
+  return { row: 0, code: "", message: "" };
+}
+
+export const StockImportError = {
+  encode(message: StockImportError, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.row !== 0) {
+      writer.uint32(8).int32(message.row);
+    }
+    if (message.code !== "") {
+      writer.uint32(18).string(message.code);
+    }
+    if (message.message !== "") {
+      writer.uint32(26).string(message.message);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StockImportError {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStockImportError();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.row = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.message = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StockImportError {
+    return {
+      row: isSet(object.row) ? Number(object.row) : 0,
+      code: isSet(object.code) ? String(object.code) : "",
+      message: isSet(object.message) ? String(object.message) : "",
+    };
+  },
+
+  toJSON(message: StockImportError): unknown {
+    const obj: any = {};
+    if (message.row !== 0) {
+      obj.row = Math.round(message.row);
+    }
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.message !== "") {
+      obj.message = message.message;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<StockImportError>, I>>(base?: I): StockImportError {
+    return StockImportError.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<StockImportError>, I>>(object: I): StockImportError {
+    const message = createBaseStockImportError();
+    message.row = object.row ?? 0;
+    message.code = object.code ?? "";
+    message.message = object.message ?? "";
+    return message;
+  },
+};
+
+function createBaseStockImportResult(): StockImportResult {
+  return { imported: 0, errors: [] };
+}
+
+export const StockImportResult = {
+  encode(message: StockImportResult, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.imported !== 0) {
+      writer.uint32(8).int32(message.imported);
+    }
+    for (const v of message.errors) {
+      StockImportError.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StockImportResult {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStockImportResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.imported = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.errors.push(StockImportError.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StockImportResult {
+    return {
+      imported: isSet(object.imported) ? Number(object.imported) : 0,
+      errors: Array.isArray(object?.errors) ? object.errors.map((e: any) => StockImportError.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: StockImportResult): unknown {
+    const obj: any = {};
+    if (message.imported !== 0) {
+      obj.imported = Math.round(message.imported);
+    }
+    if (message.errors?.length) {
+      obj.errors = message.errors.map((e) => StockImportError.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<StockImportResult>, I>>(base?: I): StockImportResult {
+    return StockImportResult.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<StockImportResult>, I>>(object: I): StockImportResult {
+    const message = createBaseStockImportResult();
+    message.imported = object.imported ?? 0;
+    message.errors = object.errors?.map((e) => StockImportError.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseStockExportRequest(): StockExportRequest {
+  return { format: "" };
+}
+
+export const StockExportRequest = {
+  encode(message: StockExportRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.format !== "") {
+      writer.uint32(10).string(message.format);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StockExportRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStockExportRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.format = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StockExportRequest {
+    return { format: isSet(object.format) ? String(object.format) : "" };
+  },
+
+  toJSON(message: StockExportRequest): unknown {
+    const obj: any = {};
+    if (message.format !== "") {
+      obj.format = message.format;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<StockExportRequest>, I>>(base?: I): StockExportRequest {
+    return StockExportRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<StockExportRequest>, I>>(object: I): StockExportRequest {
+    const message = createBaseStockExportRequest();
+    message.format = object.format ?? "";
+    return message;
+  },
+};
+
+function createBaseStockExport(): StockExport {
+  return { format: "", data: Buffer.alloc(0) };
+}
+
+export const StockExport = {
+  encode(message: StockExport, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.format !== "") {
+      writer.uint32(10).string(message.format);
+    }
+    if (message.data.length !== 0) {
+      writer.uint32(18).bytes(message.data);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StockExport {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStockExport();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.format = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.data = reader.bytes() as Buffer;
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StockExport {
+    return {
+      format: isSet(object.format) ? String(object.format) : "",
+      data: isSet(object.data) ? Buffer.from(bytesFromBase64(object.data)) : Buffer.alloc(0),
+    };
+  },
+
+  toJSON(message: StockExport): unknown {
+    const obj: any = {};
+    if (message.format !== "") {
+      obj.format = message.format;
+    }
+    if (message.data.length !== 0) {
+      obj.data = base64FromBytes(message.data);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<StockExport>, I>>(base?: I): StockExport {
+    return StockExport.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<StockExport>, I>>(object: I): StockExport {
+    const message = createBaseStockExport();
+    message.format = object.format ?? "";
+    message.data = object.data ?? Buffer.alloc(0);
+    return message;
+  },
+};
+
+function createBaseNewCorporateAction(): NewCorporateAction {
+  return { code: "", type: 0, oldShares: 0, newShares: 0, shares: 0, effectiveAt: undefined };
+}
+
+export const NewCorporateAction = {
+  encode(message: NewCorporateAction, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    if (message.type !== 0) {
+      writer.uint32(16).int32(message.type);
+    }
+    if (message.oldShares !== 0) {
+      writer.uint32(24).int64(message.oldShares);
+    }
+    if (message.newShares !== 0) {
+      writer.uint32(32).int64(message.newShares);
+    }
+    if (message.shares !== 0) {
+      writer.uint32(40).int64(message.shares);
+    }
+    if (message.effectiveAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.effectiveAt), writer.uint32(50).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): NewCorporateAction {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseNewCorporateAction();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.oldShares = longToNumber(reader.int64() as Long);
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.newShares = longToNumber(reader.int64() as Long);
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.shares = longToNumber(reader.int64() as Long);
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.effectiveAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): NewCorporateAction {
+    return {
+      code: isSet(object.code) ? String(object.code) : "",
+      type: isSet(object.type) ? corporateActionTypeFromJSON(object.type) : 0,
+      oldShares: isSet(object.oldShares) ? Number(object.oldShares) : 0,
+      newShares: isSet(object.newShares) ? Number(object.newShares) : 0,
+      shares: isSet(object.shares) ? Number(object.shares) : 0,
+      effectiveAt: isSet(object.effectiveAt) ? fromJsonTimestamp(object.effectiveAt) : undefined,
+    };
+  },
+
+  toJSON(message: NewCorporateAction): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.type !== 0) {
+      obj.type = corporateActionTypeToJSON(message.type);
+    }
+    if (message.oldShares !== 0) {
+      obj.oldShares = Math.round(message.oldShares);
+    }
+    if (message.newShares !== 0) {
+      obj.newShares = Math.round(message.newShares);
+    }
+    if (message.shares !== 0) {
+      obj.shares = Math.round(message.shares);
+    }
+    if (message.effectiveAt !== undefined) {
+      obj.effectiveAt = message.effectiveAt.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<NewCorporateAction>, I>>(base?: I): NewCorporateAction {
+    return NewCorporateAction.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<NewCorporateAction>, I>>(object: I): NewCorporateAction {
+    const message = createBaseNewCorporateAction();
+    message.code = object.code ?? "";
+    message.type = object.type ?? 0;
+    message.oldShares = object.oldShares ?? 0;
+    message.newShares = object.newShares ?? 0;
+    message.shares = object.shares ?? 0;
+    message.effectiveAt = object.effectiveAt ?? undefined;
+    return message;
+  },
+};
+
+function createBaseCorporateAction(): CorporateAction {
+  return {
+    id: 0,
+    code: "",
+    type: 0,
+    oldShares: 0,
+    newShares: 0,
+    shares: 0,
+    effectiveAt: undefined,
+    appliedAt: undefined,
+    sharesBefore: 0,
+    sharesAfter: 0,
+  };
+}
+
+export const CorporateAction = {
+  encode(message: CorporateAction, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.code !== "") {
+      writer.uint32(18).string(message.code);
+    }
+    if (message.type !== 0) {
+      writer.uint32(24).int32(message.type);
+    }
+    if (message.oldShares !== 0) {
+      writer.uint32(32).int64(message.oldShares);
+    }
+    if (message.newShares !== 0) {
+      writer.uint32(40).int64(message.newShares);
+    }
+    if (message.shares !== 0) {
+      writer.uint32(48).int64(message.shares);
+    }
+    if (message.effectiveAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.effectiveAt), writer.uint32(58).fork()).ldelim();
+    }
+    if (message.appliedAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.appliedAt), writer.uint32(66).fork()).ldelim();
+    }
+    if (message.sharesBefore !== 0) {
+      writer.uint32(72).int64(message.sharesBefore);
+    }
+    if (message.sharesAfter !== 0) {
+      writer.uint32(80).int64(message.sharesAfter);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CorporateAction {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCorporateAction();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.oldShares = longToNumber(reader.int64() as Long);
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.newShares = longToNumber(reader.int64() as Long);
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.shares = longToNumber(reader.int64() as Long);
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.effectiveAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.appliedAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.sharesBefore = longToNumber(reader.int64() as Long);
+          continue;
+        case 10:
+          if (tag !== 80) {
+            break;
+          }
+
+          message.sharesAfter = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CorporateAction {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      code: isSet(object.code) ? String(object.code) : "",
+      type: isSet(object.type) ? corporateActionTypeFromJSON(object.type) : 0,
+      oldShares: isSet(object.oldShares) ? Number(object.oldShares) : 0,
+      newShares: isSet(object.newShares) ? Number(object.newShares) : 0,
+      shares: isSet(object.shares) ? Number(object.shares) : 0,
+      effectiveAt: isSet(object.effectiveAt) ? fromJsonTimestamp(object.effectiveAt) : undefined,
+      appliedAt: isSet(object.appliedAt) ? fromJsonTimestamp(object.appliedAt) : undefined,
+      sharesBefore: isSet(object.sharesBefore) ? Number(object.sharesBefore) : 0,
+      sharesAfter: isSet(object.sharesAfter) ? Number(object.sharesAfter) : 0,
+    };
+  },
+
+  toJSON(message: CorporateAction): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.type !== 0) {
+      obj.type = corporateActionTypeToJSON(message.type);
+    }
+    if (message.oldShares !== 0) {
+      obj.oldShares = Math.round(message.oldShares);
+    }
+    if (message.newShares !== 0) {
+      obj.newShares = Math.round(message.newShares);
+    }
+    if (message.shares !== 0) {
+      obj.shares = Math.round(message.shares);
+    }
+    if (message.effectiveAt !== undefined) {
+      obj.effectiveAt = message.effectiveAt.toISOString();
+    }
+    if (message.appliedAt !== undefined) {
+      obj.appliedAt = message.appliedAt.toISOString();
+    }
+    if (message.sharesBefore !== 0) {
+      obj.sharesBefore = Math.round(message.sharesBefore);
+    }
+    if (message.sharesAfter !== 0) {
+      obj.sharesAfter = Math.round(message.sharesAfter);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CorporateAction>, I>>(base?: I): CorporateAction {
+    return CorporateAction.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CorporateAction>, I>>(object: I): CorporateAction {
+    const message = createBaseCorporateAction();
+    message.id = object.id ?? 0;
+    message.code = object.code ?? "";
+    message.type = object.type ?? 0;
+    message.oldShares = object.oldShares ?? 0;
+    message.newShares = object.newShares ?? 0;
+    message.shares = object.shares ?? 0;
+    message.effectiveAt = object.effectiveAt ?? undefined;
+    message.appliedAt = object.appliedAt ?? undefined;
+    message.sharesBefore = object.sharesBefore ?? 0;
+    message.sharesAfter = object.sharesAfter ?? 0;
+    return message;
+  },
+};
+
+function createBaseCorporateActionList(): CorporateActionList {
+  return { corporateActionList: [] };
+}
+
+export const CorporateActionList = {
+  encode(message: CorporateActionList, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.corporateActionList) {
+      CorporateAction.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CorporateActionList {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCorporateActionList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.corporateActionList.push(CorporateAction.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CorporateActionList {
+    return {
+      corporateActionList: Array.isArray(object?.corporateActionList)
+        ? object.corporateActionList.map((e: any) => CorporateAction.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: CorporateActionList): unknown {
+    const obj: any = {};
+    if (message.corporateActionList?.length) {
+      obj.corporateActionList = message.corporateActionList.map((e) => CorporateAction.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CorporateActionList>, I>>(base?: I): CorporateActionList {
+    return CorporateActionList.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CorporateActionList>, I>>(object: I): CorporateActionList {
+    const message = createBaseCorporateActionList();
+    message.corporateActionList = object.corporateActionList?.map((e) => CorporateAction.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseShareCountRequest(): ShareCountRequest {
+  return { code: "", asOf: undefined };
+}
+
+export const ShareCountRequest = {
+  encode(message: ShareCountRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    if (message.asOf !== undefined) {
+      Timestamp.encode(toTimestamp(message.asOf), writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ShareCountRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShareCountRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.asOf = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ShareCountRequest {
+    return {
+      code: isSet(object.code) ? String(object.code) : "",
+      asOf: isSet(object.asOf) ? fromJsonTimestamp(object.asOf) : undefined,
+    };
+  },
+
+  toJSON(message: ShareCountRequest): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.asOf !== undefined) {
+      obj.asOf = message.asOf.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ShareCountRequest>, I>>(base?: I): ShareCountRequest {
+    return ShareCountRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ShareCountRequest>, I>>(object: I): ShareCountRequest {
+    const message = createBaseShareCountRequest();
+    message.code = object.code ?? "";
+    message.asOf = object.asOf ?? undefined;
+    return message;
+  },
+};
+
+function createBaseShareCount(): ShareCount {
+  return { code: "", asOf: undefined, totalStockCount: 0 };
+}
+
+export const ShareCount = {
+  encode(message: ShareCount, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    if (message.asOf !== undefined) {
+      Timestamp.encode(toTimestamp(message.asOf), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.totalStockCount !== 0) {
+      writer.uint32(24).int64(message.totalStockCount);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ShareCount {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseShareCount();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.asOf = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.totalStockCount = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ShareCount {
+    return {
+      code: isSet(object.code) ? String(object.code) : "",
+      asOf: isSet(object.asOf) ? fromJsonTimestamp(object.asOf) : undefined,
+      totalStockCount: isSet(object.totalStockCount) ? Number(object.totalStockCount) : 0,
+    };
+  },
+
+  toJSON(message: ShareCount): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.asOf !== undefined) {
+      obj.asOf = message.asOf.toISOString();
+    }
+    if (message.totalStockCount !== 0) {
+      obj.totalStockCount = Math.round(message.totalStockCount);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<ShareCount>, I>>(base?: I): ShareCount {
+    return ShareCount.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<ShareCount>, I>>(object: I): ShareCount {
+    const message = createBaseShareCount();
+    message.code = object.code ?? "";
+    message.asOf = object.asOf ?? undefined;
+    message.totalStockCount = object.totalStockCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseNewWatchlist(): NewWatchlist {
+  return { name: "", codes: [] };
+}
+
+export const NewWatchlist = {
+  encode(message: NewWatchlist, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    for (const v of message.codes) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): NewWatchlist {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseNewWatchlist();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.codes.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): NewWatchlist {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      codes: Array.isArray(object?.codes) ? object.codes.map((e: any) => String(e)) : [],
+    };
+  },
+
+  toJSON(message: NewWatchlist): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.codes?.length) {
+      obj.codes = message.codes;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<NewWatchlist>, I>>(base?: I): NewWatchlist {
+    return NewWatchlist.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<NewWatchlist>, I>>(object: I): NewWatchlist {
+    const message = createBaseNewWatchlist();
+    message.name = object.name ?? "";
+    message.codes = object.codes?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseWatchlist(): Watchlist {
+  return { id: 0, name: "", codes: [], createdAt: undefined };
+}
+
+export const Watchlist = {
+  encode(message: Watchlist, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    for (const v of message.codes) {
+      writer.uint32(26).string(v!);
+    }
+    if (message.createdAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAt), writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Watchlist {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWatchlist();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.codes.push(reader.string());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.createdAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Watchlist {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      name: isSet(object.name) ? String(object.name) : "",
+      codes: Array.isArray(object?.codes) ? object.codes.map((e: any) => String(e)) : [],
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+    };
+  },
+
+  toJSON(message: Watchlist): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.codes?.length) {
+      obj.codes = message.codes;
+    }
+    if (message.createdAt !== undefined) {
+      obj.createdAt = message.createdAt.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Watchlist>, I>>(base?: I): Watchlist {
+    return Watchlist.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<Watchlist>, I>>(object: I): Watchlist {
+    const message = createBaseWatchlist();
+    message.id = object.id ?? 0;
+    message.name = object.name ?? "";
+    message.codes = object.codes?.map((e) => e) || [];
+    message.createdAt = object.createdAt ?? undefined;
+    return message;
+  },
+};
+
+function createBaseWatchlistId(): WatchlistId {
+  return { id: 0 };
+}
+
+export const WatchlistId = {
+  encode(message: WatchlistId, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WatchlistId {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWatchlistId();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WatchlistId {
+    return { id: isSet(object.id) ? Number(object.id) : 0 };
+  },
+
+  toJSON(message: WatchlistId): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WatchlistId>, I>>(base?: I): WatchlistId {
+    return WatchlistId.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<WatchlistId>, I>>(object: I): WatchlistId {
+    const message = createBaseWatchlistId();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseWatchlistList(): WatchlistList {
+  return { watchlistList: [] };
+}
+
+export const WatchlistList = {
+  encode(message: WatchlistList, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.watchlistList) {
+      Watchlist.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WatchlistList {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWatchlistList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.watchlistList.push(Watchlist.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WatchlistList {
+    return {
+      watchlistList: Array.isArray(object?.watchlistList)
+        ? object.watchlistList.map((e: any) => Watchlist.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: WatchlistList): unknown {
+    const obj: any = {};
+    if (message.watchlistList?.length) {
+      obj.watchlistList = message.watchlistList.map((e) => Watchlist.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WatchlistList>, I>>(base?: I): WatchlistList {
+    return WatchlistList.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<WatchlistList>, I>>(object: I): WatchlistList {
+    const message = createBaseWatchlistList();
+    message.watchlistList = object.watchlistList?.map((e) => Watchlist.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseWatchlistUpdate(): WatchlistUpdate {
+  return { id: 0, name: "", codes: [] };
+}
+
+export const WatchlistUpdate = {
+  encode(message: WatchlistUpdate, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    for (const v of message.codes) {
+      writer.uint32(26).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WatchlistUpdate {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWatchlistUpdate();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.codes.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WatchlistUpdate {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      name: isSet(object.name) ? String(object.name) : "",
+      codes: Array.isArray(object?.codes) ? object.codes.map((e: any) => String(e)) : [],
+    };
+  },
+
+  toJSON(message: WatchlistUpdate): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.codes?.length) {
+      obj.codes = message.codes;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<WatchlistUpdate>, I>>(base?: I): WatchlistUpdate {
+    return WatchlistUpdate.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<WatchlistUpdate>, I>>(object: I): WatchlistUpdate {
+    const message = createBaseWatchlistUpdate();
+    message.id = object.id ?? 0;
+    message.name = object.name ?? "";
+    message.codes = object.codes?.map((e) => e) || [];
+    return message;
+  },
+};
+
+export type StocksService = typeof StocksService;
+export const StocksService = {
+  listStocks: {
+    path: "/stocks.Stocks/ListStocks",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: StockFilter) => Buffer.from(StockFilter.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => StockFilter.decode(value),
+    responseSerialize: (value: StockList) => Buffer.from(StockList.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => StockList.decode(value),
+  },
+  getStockByCode: {
+    path: "/stocks.Stocks/GetStockByCode",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: StockCode) => Buffer.from(StockCode.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => StockCode.decode(value),
+    responseSerialize: (value: Stock) => Buffer.from(Stock.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Stock.decode(value),
+  },
+  getStockById: {
+    path: "/stocks.Stocks/GetStockById",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: StockId) => Buffer.from(StockId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => StockId.decode(value),
+    responseSerialize: (value: Stock) => Buffer.from(Stock.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Stock.decode(value),
+  },
+  streamQuotes: {
+    path: "/stocks.Stocks/StreamQuotes",
+    requestStream: false,
+    responseStream: true,
+    requestSerialize: (value: QuoteRequest) => Buffer.from(QuoteRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => QuoteRequest.decode(value),
+    responseSerialize: (value: Quote) => Buffer.from(Quote.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Quote.decode(value),
+  },
+  getCandles: {
+    path: "/stocks.Stocks/GetCandles",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: CandleRequest) => Buffer.from(CandleRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => CandleRequest.decode(value),
+    responseSerialize: (value: CandleList) => Buffer.from(CandleList.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => CandleList.decode(value),
+  },
+  rebuildCandles: {
+    path: "/stocks.Stocks/RebuildCandles",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: CandleRequest) => Buffer.from(CandleRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => CandleRequest.decode(value),
+    responseSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Empty.decode(value),
+  },
+  importStocks: {
+    path: "/stocks.Stocks/ImportStocks",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: StockImport) => Buffer.from(StockImport.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => StockImport.decode(value),
+    responseSerialize: (value: StockImportResult) => Buffer.from(StockImportResult.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => StockImportResult.decode(value),
+  },
+  exportStocks: {
+    path: "/stocks.Stocks/ExportStocks",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: StockExportRequest) => Buffer.from(StockExportRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => StockExportRequest.decode(value),
+    responseSerialize: (value: StockExport) => Buffer.from(StockExport.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => StockExport.decode(value),
+  },
+  /**
+   * CreateCorporateAction schedules a share count change. When a split or a
+   * reverse split is applied, the quotes, candles and holdings of the stock
+   * are rescaled and its open orders are cancelled and sent on
+   * Trading.StreamOrders. Past orders and trades keep their original prices
+   * and quantities.
+   */
+  createCorporateAction: {
+    path: "/stocks.Stocks/CreateCorporateAction",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: NewCorporateAction) => Buffer.from(NewCorporateAction.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => NewCorporateAction.decode(value),
+    responseSerialize: (value: CorporateAction) => Buffer.from(CorporateAction.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => CorporateAction.decode(value),
+  },
+  listCorporateActions: {
+    path: "/stocks.Stocks/ListCorporateActions",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: StockCode) => Buffer.from(StockCode.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => StockCode.decode(value),
+    responseSerialize: (value: CorporateActionList) => Buffer.from(CorporateActionList.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => CorporateActionList.decode(value),
+  },
+  getShareCount: {
+    path: "/stocks.Stocks/GetShareCount",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: ShareCountRequest) => Buffer.from(ShareCountRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => ShareCountRequest.decode(value),
+    responseSerialize: (value: ShareCount) => Buffer.from(ShareCount.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => ShareCount.decode(value),
+  },
+  createWatchlist: {
+    path: "/stocks.Stocks/CreateWatchlist",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: NewWatchlist) => Buffer.from(NewWatchlist.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => NewWatchlist.decode(value),
+    responseSerialize: (value: Watchlist) => Buffer.from(Watchlist.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Watchlist.decode(value),
+  },
+  listWatchlists: {
+    path: "/stocks.Stocks/ListWatchlists",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => Empty.decode(value),
+    responseSerialize: (value: WatchlistList) => Buffer.from(WatchlistList.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => WatchlistList.decode(value),
+  },
+  getWatchlist: {
+    path: "/stocks.Stocks/GetWatchlist",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: WatchlistId) => Buffer.from(WatchlistId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => WatchlistId.decode(value),
+    responseSerialize: (value: Watchlist) => Buffer.from(Watchlist.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Watchlist.decode(value),
+  },
+  updateWatchlist: {
+    path: "/stocks.Stocks/UpdateWatchlist",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: WatchlistUpdate) => Buffer.from(WatchlistUpdate.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => WatchlistUpdate.decode(value),
+    responseSerialize: (value: Watchlist) => Buffer.from(Watchlist.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Watchlist.decode(value),
+  },
+  deleteWatchlist: {
+    path: "/stocks.Stocks/DeleteWatchlist",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: WatchlistId) => Buffer.from(WatchlistId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => WatchlistId.decode(value),
+    responseSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Empty.decode(value),
+  },
+  streamWatchlist: {
+    path: "/stocks.Stocks/StreamWatchlist",
+    requestStream: false,
+    responseStream: true,
+    requestSerialize: (value: WatchlistId) => Buffer.from(WatchlistId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => WatchlistId.decode(value),
+    responseSerialize: (value: Quote) => Buffer.from(Quote.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Quote.decode(value),
+  },
+} as const;
+
+export interface StocksServer extends UntypedServiceImplementation {
+  listStocks: handleUnaryCall<StockFilter, StockList>;
+  getStockByCode: handleUnaryCall<StockCode, Stock>;
+  getStockById: handleUnaryCall<StockId, Stock>;
+  streamQuotes: handleServerStreamingCall<QuoteRequest, Quote>;
+  getCandles: handleUnaryCall<CandleRequest, CandleList>;
+  rebuildCandles: handleUnaryCall<CandleRequest, Empty>;
+  importStocks: handleUnaryCall<StockImport, StockImportResult>;
+  exportStocks: handleUnaryCall<StockExportRequest, StockExport>;
+  /**
+   * CreateCorporateAction schedules a share count change. When a split or a
+   * reverse split is applied, the quotes, candles and holdings of the stock
+   * are rescaled and its open orders are cancelled and sent on
+   * Trading.StreamOrders. Past orders and trades keep their original prices
+   * and quantities.
+   */
+  createCorporateAction: handleUnaryCall<NewCorporateAction, CorporateAction>;
+  listCorporateActions: handleUnaryCall<StockCode, CorporateActionList>;
+  getShareCount: handleUnaryCall<ShareCountRequest, ShareCount>;
+  createWatchlist: handleUnaryCall<NewWatchlist, Watchlist>;
+  listWatchlists: handleUnaryCall<Empty, WatchlistList>;
+  getWatchlist: handleUnaryCall<WatchlistId, Watchlist>;
+  updateWatchlist: handleUnaryCall<WatchlistUpdate, Watchlist>;
+  deleteWatchlist: handleUnaryCall<WatchlistId, Empty>;
+  streamWatchlist: handleServerStreamingCall<WatchlistId, Quote>;
+}
+
+export interface StocksClient extends Client {
+  listStocks(
+    request: StockFilter,
+    callback: (error: ServiceError | null, response: StockList) => void,
+  ): ClientUnaryCall;
+  listStocks(
+    request: StockFilter,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: StockList) => void,
+  ): ClientUnaryCall;
+  listStocks(
+    request: StockFilter,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: StockList) => void,
+  ): ClientUnaryCall;
+  getStockByCode(request: StockCode, callback: (error: ServiceError | null, response: Stock) => void): ClientUnaryCall;
+  getStockByCode(
+    request: StockCode,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Stock) => void,
+  ): ClientUnaryCall;
+  getStockByCode(
+    request: StockCode,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Stock) => void,
+  ): ClientUnaryCall;
+  getStockById(request: StockId, callback: (error: ServiceError | null, response: Stock) => void): ClientUnaryCall;
+  getStockById(
+    request: StockId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Stock) => void,
+  ): ClientUnaryCall;
+  getStockById(
+    request: StockId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Stock) => void,
+  ): ClientUnaryCall;
+  streamQuotes(request: QuoteRequest, options?: Partial<CallOptions>): ClientReadableStream<Quote>;
+  streamQuotes(request: QuoteRequest, metadata?: Metadata, options?: Partial<CallOptions>): ClientReadableStream<Quote>;
+  getCandles(
+    request: CandleRequest,
+    callback: (error: ServiceError | null, response: CandleList) => void,
+  ): ClientUnaryCall;
+  getCandles(
+    request: CandleRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: CandleList) => void,
+  ): ClientUnaryCall;
+  getCandles(
+    request: CandleRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: CandleList) => void,
+  ): ClientUnaryCall;
+  rebuildCandles(
+    request: CandleRequest,
+    callback: (error: ServiceError | null, response: Empty) => void,
+  ): ClientUnaryCall;
+  rebuildCandles(
+    request: CandleRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Empty) => void,
+  ): ClientUnaryCall;
+  rebuildCandles(
+    request: CandleRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Empty) => void,
+  ): ClientUnaryCall;
+  importStocks(
+    request: StockImport,
+    callback: (error: ServiceError | null, response: StockImportResult) => void,
+  ): ClientUnaryCall;
+  importStocks(
+    request: StockImport,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: StockImportResult) => void,
+  ): ClientUnaryCall;
+  importStocks(
+    request: StockImport,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: StockImportResult) => void,
+  ): ClientUnaryCall;
+  exportStocks(
+    request: StockExportRequest,
+    callback: (error: ServiceError | null, response: StockExport) => void,
+  ): ClientUnaryCall;
+  exportStocks(
+    request: StockExportRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: StockExport) => void,
+  ): ClientUnaryCall;
+  exportStocks(
+    request: StockExportRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: StockExport) => void,
+  ): ClientUnaryCall;
+  /**
+   * CreateCorporateAction schedules a share count change. When a split or a
+   * reverse split is applied, the quotes, candles and holdings of the stock
+   * are rescaled and its open orders are cancelled and sent on
+   * Trading.StreamOrders. Past orders and trades keep their original prices
+   * and quantities.
+   */
+  createCorporateAction(
+    request: NewCorporateAction,
+    callback: (error: ServiceError | null, response: CorporateAction) => void,
+  ): ClientUnaryCall;
+  createCorporateAction(
+    request: NewCorporateAction,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: CorporateAction) => void,
+  ): ClientUnaryCall;
+  createCorporateAction(
+    request: NewCorporateAction,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: CorporateAction) => void,
+  ): ClientUnaryCall;
+  listCorporateActions(
+    request: StockCode,
+    callback: (error: ServiceError | null, response: CorporateActionList) => void,
+  ): ClientUnaryCall;
+  listCorporateActions(
+    request: StockCode,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: CorporateActionList) => void,
+  ): ClientUnaryCall;
+  listCorporateActions(
+    request: StockCode,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: CorporateActionList) => void,
+  ): ClientUnaryCall;
+  getShareCount(
+    request: ShareCountRequest,
+    callback: (error: ServiceError | null, response: ShareCount) => void,
+  ): ClientUnaryCall;
+  getShareCount(
+    request: ShareCountRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: ShareCount) => void,
+  ): ClientUnaryCall;
+  getShareCount(
+    request: ShareCountRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: ShareCount) => void,
+  ): ClientUnaryCall;
+  createWatchlist(
+    request: NewWatchlist,
+    callback: (error: ServiceError | null, response: Watchlist) => void,
+  ): ClientUnaryCall;
+  createWatchlist(
+    request: NewWatchlist,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Watchlist) => void,
+  ): ClientUnaryCall;
+  createWatchlist(
+    request: NewWatchlist,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Watchlist) => void,
+  ): ClientUnaryCall;
+  listWatchlists(
+    request: Empty,
+    callback: (error: ServiceError | null, response: WatchlistList) => void,
+  ): ClientUnaryCall;
+  listWatchlists(
+    request: Empty,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: WatchlistList) => void,
+  ): ClientUnaryCall;
+  listWatchlists(
+    request: Empty,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: WatchlistList) => void,
+  ): ClientUnaryCall;
+  getWatchlist(
+    request: WatchlistId,
+    callback: (error: ServiceError | null, response: Watchlist) => void,
+  ): ClientUnaryCall;
+  getWatchlist(
+    request: WatchlistId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Watchlist) => void,
+  ): ClientUnaryCall;
+  getWatchlist(
+    request: WatchlistId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Watchlist) => void,
+  ): ClientUnaryCall;
+  updateWatchlist(
+    request: WatchlistUpdate,
+    callback: (error: ServiceError | null, response: Watchlist) => void,
+  ): ClientUnaryCall;
+  updateWatchlist(
+    request: WatchlistUpdate,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Watchlist) => void,
+  ): ClientUnaryCall;
+  updateWatchlist(
+    request: WatchlistUpdate,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Watchlist) => void,
+  ): ClientUnaryCall;
+  deleteWatchlist(
+    request: WatchlistId,
+    callback: (error: ServiceError | null, response: Empty) => void,
+  ): ClientUnaryCall;
+  deleteWatchlist(
+    request: WatchlistId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Empty) => void,
+  ): ClientUnaryCall;
+  deleteWatchlist(
+    request: WatchlistId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Empty) => void,
+  ): ClientUnaryCall;
+  streamWatchlist(request: WatchlistId, options?: Partial<CallOptions>): ClientReadableStream<Quote>;
+  streamWatchlist(
+    request: WatchlistId,
+    metadata?: Metadata,
+    options?: Partial<CallOptions>,
+  ): ClientReadableStream<Quote>;
+}
+
+export const StocksClient = makeGenericClientConstructor(StocksService, "stocks.Stocks") as unknown as {
+  new (address: string, credentials: ChannelCredentials, options?: Partial<ClientOptions>): StocksClient;
+  service: typeof StocksService;
+};
+
+declare const self: any | undefined;
+declare const window: any | undefined;
+declare const global: any | undefined;
+const tsProtoGlobalThis: any = (() => {
+  if (typeof globalThis !== "undefined") {
+    return globalThis;
+  }
+  if (typeof self !== "undefined") {
+    return self;
+  }
+  if (typeof window !== "undefined") {
+    return window;
+  }
+  if (typeof global !== "undefined") {
+    return global;
+  }
+  throw "Unable to locate global object";
+})();
+
+function bytesFromBase64(b64: string): Uint8Array {
+  if (tsProtoGlobalThis.Buffer) {
+    return Uint8Array.from(tsProtoGlobalThis.Buffer.from(b64, "base64"));
+  } else {
+    const bin = tsProtoGlobalThis.atob(b64);
+    const arr = new Uint8Array(bin.length);
+    for (let i = 0; i < bin.length; ++i) {
+      arr[i] = bin.charCodeAt(i);
+    }
+    return arr;
+  }
+}
+
+function base64FromBytes(arr: Uint8Array): string {
+  if (tsProtoGlobalThis.Buffer) {
+    return tsProtoGlobalThis.Buffer.from(arr).toString("base64");
+  } else {
+    const bin: string[] = [];
+    arr.forEach((byte) => {
+      bin.push(String.fromCharCode(byte));
+    });
+    return tsProtoGlobalThis.btoa(bin.join(""));
+  }
+}
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends Array<infer U> ? Array<DeepPartial<U>> : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+type KeysOfUnion<T> = T extends T ? keyof T : never;
+export type Exact<P, I extends P> = P extends Builtin ? P
+  : P & { [K in keyof P]: Exact<P[K], I[K]> } & { [K in Exclude<keyof I, KeysOfUnion<P>>]: never };
+
+function toTimestamp(date: Date): Timestamp {
+  const seconds = Math.trunc(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function longToNumber(long: Long): number {
+  if (long.gt(Number.MAX_SAFE_INTEGER)) {
+    throw new tsProtoGlobalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
+  }
+  return long.toNumber();
+}
+
+if (_m0.util.Long !== Long) {
+  _m0.util.Long = Long as any;
+  _m0.configure();
+}
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
@@ -0,0 +1,2350 @@
+/* eslint-disable */
+import {
+  CallOptions,
+  ChannelCredentials,
+  Client,
+  ClientOptions,
+  ClientReadableStream,
+  ClientUnaryCall,
+  handleServerStreamingCall,
+  handleUnaryCall,
+  makeGenericClientConstructor,
+  Metadata,
+  ServiceError,
+  UntypedServiceImplementation,
+} from "@grpc/grpc-js";
+import Long from "long";
+import _m0 from "protobufjs/minimal";
+import { Empty } from "./google/protobuf/empty";
+import { Timestamp } from "./google/protobuf/timestamp";
+
+export const protobufPackage = "trading";
+
+export enum Side {
+  SIDE_UNSPECIFIED = 0,
+  BUY = 1,
+  SELL = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function sideFromJSON(object: any): Side {
+  switch (object) {
+    case 0:
+    case "SIDE_UNSPECIFIED":
+      return Side.SIDE_UNSPECIFIED;
+    case 1:
+    case "BUY":
+      return Side.BUY;
+    case 2:
+    case "SELL":
+      return Side.SELL;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return Side.UNRECOGNIZED;
+  }
+}
+
+export function sideToJSON(object: Side): string {
+  switch (object) {
+    case Side.SIDE_UNSPECIFIED:
+      return "SIDE_UNSPECIFIED";
+    case Side.BUY:
+      return "BUY";
+    case Side.SELL:
+      return "SELL";
+    case Side.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export enum OrderType {
+  ORDER_TYPE_UNSPECIFIED = 0,
+  LIMIT = 1,
+  MARKET = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function orderTypeFromJSON(object: any): OrderType {
+  switch (object) {
+    case 0:
+    case "ORDER_TYPE_UNSPECIFIED":
+      return OrderType.ORDER_TYPE_UNSPECIFIED;
+    case 1:
+    case "LIMIT":
+      return OrderType.LIMIT;
+    case 2:
+    case "MARKET":
+      return OrderType.MARKET;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return OrderType.UNRECOGNIZED;
+  }
+}
+
+export function orderTypeToJSON(object: OrderType): string {
+  switch (object) {
+    case OrderType.ORDER_TYPE_UNSPECIFIED:
+      return "ORDER_TYPE_UNSPECIFIED";
+    case OrderType.LIMIT:
+      return "LIMIT";
+    case OrderType.MARKET:
+      return "MARKET";
+    case OrderType.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export enum OrderStatus {
+  ORDER_STATUS_UNSPECIFIED = 0,
+  OPEN = 1,
+  PARTIALLY_FILLED = 2,
+  FILLED = 3,
+  CANCELLED = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function orderStatusFromJSON(object: any): OrderStatus {
+  switch (object) {
+    case 0:
+    case "ORDER_STATUS_UNSPECIFIED":
+      return OrderStatus.ORDER_STATUS_UNSPECIFIED;
+    case 1:
+    case "OPEN":
+      return OrderStatus.OPEN;
+    case 2:
+    case "PARTIALLY_FILLED":
+      return OrderStatus.PARTIALLY_FILLED;
+    case 3:
+    case "FILLED":
+      return OrderStatus.FILLED;
+    case 4:
+    case "CANCELLED":
+      return OrderStatus.CANCELLED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return OrderStatus.UNRECOGNIZED;
+  }
+}
+
+export function orderStatusToJSON(object: OrderStatus): string {
+  switch (object) {
+    case OrderStatus.ORDER_STATUS_UNSPECIFIED:
+      return "ORDER_STATUS_UNSPECIFIED";
+    case OrderStatus.OPEN:
+      return "OPEN";
+    case OrderStatus.PARTIALLY_FILLED:
+      return "PARTIALLY_FILLED";
+    case OrderStatus.FILLED:
+      return "FILLED";
+    case OrderStatus.CANCELLED:
+      return "CANCELLED";
+    case OrderStatus.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export enum SessionState {
+  SESSION_STATE_UNSPECIFIED = 0,
+  SESSION_CLOSED = 1,
+  SESSION_PRE_OPEN = 2,
+  SESSION_OPEN = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function sessionStateFromJSON(object: any): SessionState {
+  switch (object) {
+    case 0:
+    case "SESSION_STATE_UNSPECIFIED":
+      return SessionState.SESSION_STATE_UNSPECIFIED;
+    case 1:
+    case "SESSION_CLOSED":
+      return SessionState.SESSION_CLOSED;
+    case 2:
+    case "SESSION_PRE_OPEN":
+      return SessionState.SESSION_PRE_OPEN;
+    case 3:
+    case "SESSION_OPEN":
+      return SessionState.SESSION_OPEN;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return SessionState.UNRECOGNIZED;
+  }
+}
+
+export function sessionStateToJSON(object: SessionState): string {
+  switch (object) {
+    case SessionState.SESSION_STATE_UNSPECIFIED:
+      return "SESSION_STATE_UNSPECIFIED";
+    case SessionState.SESSION_CLOSED:
+      return "SESSION_CLOSED";
+    case SessionState.SESSION_PRE_OPEN:
+      return "SESSION_PRE_OPEN";
+    case SessionState.SESSION_OPEN:
+      return "SESSION_OPEN";
+    case SessionState.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export interface NewOrder {
+  code: string;
+  side: Side;
+  type: OrderType;
+  price: number;
+  quantity: number;
+}
+
+export interface Order {
+  id: number;
+  accountId: string;
+  code: string;
+  side: Side;
+  type: OrderType;
+  price: number;
+  quantity: number;
+  filledQuantity: number;
+  status: OrderStatus;
+  createdAt: Date | undefined;
+}
+
+export interface OrderId {
+  id: number;
+}
+
+export interface OrderReplacement {
+  id: number;
+  price: number;
+  quantity: number;
+}
+
+export interface Trade {
+  id: number;
+  code: string;
+  price: number;
+  quantity: number;
+  buyOrderId: number;
+  sellOrderId: number;
+  time: Date | undefined;
+}
+
+export interface OrderResult {
+  order: Order | undefined;
+  trades: Trade[];
+}
+
+export interface OrderBookRequest {
+  code: string;
+  depth: number;
+}
+
+export interface PriceLevel {
+  price: number;
+  quantity: number;
+  orderCount: number;
+}
+
+export interface OrderBook {
+  code: string;
+  bids: PriceLevel[];
+  asks: PriceLevel[];
+  lastTrade: Trade | undefined;
+  time: Date | undefined;
+}
+
+export interface AccountRequest {
+  accountId: string;
+}
+
+export interface CashDeposit {
+  accountId: string;
+  amount: number;
+  memo: string;
+}
+
+export interface Balance {
+  accountId: string;
+  cash: number;
+  reserved: number;
+  buyingPower: number;
+}
+
+export interface Position {
+  code: string;
+  quantity: number;
+  reservedQuantity: number;
+  averageCost: number;
+  marketPrice: number;
+  marketValue: number;
+  unrealizedPnl: number;
+  realizedPnl: number;
+}
+
+export interface PositionList {
+  accountId: string;
+  positionList: Position[];
+}
+
+export interface PnL {
+  accountId: string;
+  realized: number;
+  unrealized: number;
+  total: number;
+  equity: number;
+}
+
+export interface MarketSession {
+  state: SessionState;
+  time: Date | undefined;
+  nextState: SessionState;
+  nextAt: Date | undefined;
+}
+
+function createBaseNewOrder(): NewOrder {
+  return { code: "", side: 0, type: 0, price: 0, quantity: 0 };
+}
+
+export const NewOrder = {
+  encode(message: NewOrder, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    if (message.side !== 0) {
+      writer.uint32(16).int32(message.side);
+    }
+    if (message.type !== 0) {
+      writer.uint32(24).int32(message.type);
+    }
+    if (message.price !== 0) {
+      writer.uint32(33).double(message.price);
+    }
+    if (message.quantity !== 0) {
+      writer.uint32(40).int64(message.quantity);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): NewOrder {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseNewOrder();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.side = reader.int32() as any;
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 4:
+          if (tag !== 33) {
+            break;
+          }
+
+          message.price = reader.double();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.quantity = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): NewOrder {
+    return {
+      code: isSet(object.code) ? String(object.code) : "",
+      side: isSet(object.side) ? sideFromJSON(object.side) : 0,
+      type: isSet(object.type) ? orderTypeFromJSON(object.type) : 0,
+      price: isSet(object.price) ? Number(object.price) : 0,
+      quantity: isSet(object.quantity) ? Number(object.quantity) : 0,
+    };
+  },
+
+  toJSON(message: NewOrder): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.side !== 0) {
+      obj.side = sideToJSON(message.side);
+    }
+    if (message.type !== 0) {
+      obj.type = orderTypeToJSON(message.type);
+    }
+    if (message.price !== 0) {
+      obj.price = message.price;
+    }
+    if (message.quantity !== 0) {
+      obj.quantity = Math.round(message.quantity);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<NewOrder>, I>>(base?: I): NewOrder {
+    return NewOrder.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<NewOrder>, I>>(object: I): NewOrder {
+    const message = createBaseNewOrder();
+    message.code = object.code ?? "";
+    message.side = object.side ?? 0;
+    message.type = object.type ?? 0;
+    message.price = object.price ?? 0;
+    message.quantity = object.quantity ?? 0;
+    return message;
+  },
+};
+
+function createBaseOrder(): Order {
+  return {
+    id: 0,
+    accountId: "",
+    code: "",
+    side: 0,
+    type: 0,
+    price: 0,
+    quantity: 0,
+    filledQuantity: 0,
+    status: 0,
+    createdAt: undefined,
+  };
+}
+
+export const Order = {
+  encode(message: Order, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.accountId !== "") {
+      writer.uint32(18).string(message.accountId);
+    }
+    if (message.code !== "") {
+      writer.uint32(26).string(message.code);
+    }
+    if (message.side !== 0) {
+      writer.uint32(32).int32(message.side);
+    }
+    if (message.type !== 0) {
+      writer.uint32(40).int32(message.type);
+    }
+    if (message.price !== 0) {
+      writer.uint32(49).double(message.price);
+    }
+    if (message.quantity !== 0) {
+      writer.uint32(56).int64(message.quantity);
+    }
+    if (message.filledQuantity !== 0) {
+      writer.uint32(64).int64(message.filledQuantity);
+    }
+    if (message.status !== 0) {
+      writer.uint32(72).int32(message.status);
+    }
+    if (message.createdAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdAt), writer.uint32(82).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Order {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOrder();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.accountId = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.side = reader.int32() as any;
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 6:
+          if (tag !== 49) {
+            break;
+          }
+
+          message.price = reader.double();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.quantity = longToNumber(reader.int64() as Long);
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.filledQuantity = longToNumber(reader.int64() as Long);
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.createdAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Order {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      accountId: isSet(object.accountId) ? String(object.accountId) : "",
+      code: isSet(object.code) ? String(object.code) : "",
+      side: isSet(object.side) ? sideFromJSON(object.side) : 0,
+      type: isSet(object.type) ? orderTypeFromJSON(object.type) : 0,
+      price: isSet(object.price) ? Number(object.price) : 0,
+      quantity: isSet(object.quantity) ? Number(object.quantity) : 0,
+      filledQuantity: isSet(object.filledQuantity) ? Number(object.filledQuantity) : 0,
+      status: isSet(object.status) ? orderStatusFromJSON(object.status) : 0,
+      createdAt: isSet(object.createdAt) ? fromJsonTimestamp(object.createdAt) : undefined,
+    };
+  },
+
+  toJSON(message: Order): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.accountId !== "") {
+      obj.accountId = message.accountId;
+    }
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.side !== 0) {
+      obj.side = sideToJSON(message.side);
+    }
+    if (message.type !== 0) {
+      obj.type = orderTypeToJSON(message.type);
+    }
+    if (message.price !== 0) {
+      obj.price = message.price;
+    }
+    if (message.quantity !== 0) {
+      obj.quantity = Math.round(message.quantity);
+    }
+    if (message.filledQuantity !== 0) {
+      obj.filledQuantity = Math.round(message.filledQuantity);
+    }
+    if (message.status !== 0) {
+      obj.status = orderStatusToJSON(message.status);
+    }
+    if (message.createdAt !== undefined) {
+      obj.createdAt = message.createdAt.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Order>, I>>(base?: I): Order {
+    return Order.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<Order>, I>>(object: I): Order {
+    const message = createBaseOrder();
+    message.id = object.id ?? 0;
+    message.accountId = object.accountId ?? "";
+    message.code = object.code ?? "";
+    message.side = object.side ?? 0;
+    message.type = object.type ?? 0;
+    message.price = object.price ?? 0;
+    message.quantity = object.quantity ?? 0;
+    message.filledQuantity = object.filledQuantity ?? 0;
+    message.status = object.status ?? 0;
+    message.createdAt = object.createdAt ?? undefined;
+    return message;
+  },
+};
+
+function createBaseOrderId(): OrderId {
+  return { id: 0 };
+}
+
+export const OrderId = {
+  encode(message: OrderId, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OrderId {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOrderId();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): OrderId {
+    return { id: isSet(object.id) ? Number(object.id) : 0 };
+  },
+
+  toJSON(message: OrderId): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<OrderId>, I>>(base?: I): OrderId {
+    return OrderId.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<OrderId>, I>>(object: I): OrderId {
+    const message = createBaseOrderId();
+    message.id = object.id ?? 0;
+    return message;
+  },
+};
+
+function createBaseOrderReplacement(): OrderReplacement {
+  return { id: 0, price: 0, quantity: 0 };
+}
+
+export const OrderReplacement = {
+  encode(message: OrderReplacement, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.price !== 0) {
+      writer.uint32(17).double(message.price);
+    }
+    if (message.quantity !== 0) {
+      writer.uint32(24).int64(message.quantity);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OrderReplacement {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOrderReplacement();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 17) {
+            break;
+          }
+
+          message.price = reader.double();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.quantity = longToNumber(reader.int64() as Long);
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): OrderReplacement {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      price: isSet(object.price) ? Number(object.price) : 0,
+      quantity: isSet(object.quantity) ? Number(object.quantity) : 0,
+    };
+  },
+
+  toJSON(message: OrderReplacement): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.price !== 0) {
+      obj.price = message.price;
+    }
+    if (message.quantity !== 0) {
+      obj.quantity = Math.round(message.quantity);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<OrderReplacement>, I>>(base?: I): OrderReplacement {
+    return OrderReplacement.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<OrderReplacement>, I>>(object: I): OrderReplacement {
+    const message = createBaseOrderReplacement();
+    message.id = object.id ?? 0;
+    message.price = object.price ?? 0;
+    message.quantity = object.quantity ?? 0;
+    return message;
+  },
+};
+
+function createBaseTrade(): Trade {
+  return { id: 0, code: "", price: 0, quantity: 0, buyOrderId: 0, sellOrderId: 0, time: undefined };
+}
+
+export const Trade = {
+  encode(message: Trade, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== 0) {
+      writer.uint32(8).int64(message.id);
+    }
+    if (message.code !== "") {
+      writer.uint32(18).string(message.code);
+    }
+    if (message.price !== 0) {
+      writer.uint32(25).double(message.price);
+    }
+    if (message.quantity !== 0) {
+      writer.uint32(32).int64(message.quantity);
+    }
+    if (message.buyOrderId !== 0) {
+      writer.uint32(40).int64(message.buyOrderId);
+    }
+    if (message.sellOrderId !== 0) {
+      writer.uint32(48).int64(message.sellOrderId);
+    }
+    if (message.time !== undefined) {
+      Timestamp.encode(toTimestamp(message.time), writer.uint32(58).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Trade {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTrade();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.id = longToNumber(reader.int64() as Long);
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 3:
+          if (tag !== 25) {
+            break;
+          }
+
+          message.price = reader.double();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.quantity = longToNumber(reader.int64() as Long);
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.buyOrderId = longToNumber(reader.int64() as Long);
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.sellOrderId = longToNumber(reader.int64() as Long);
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Trade {
+    return {
+      id: isSet(object.id) ? Number(object.id) : 0,
+      code: isSet(object.code) ? String(object.code) : "",
+      price: isSet(object.price) ? Number(object.price) : 0,
+      quantity: isSet(object.quantity) ? Number(object.quantity) : 0,
+      buyOrderId: isSet(object.buyOrderId) ? Number(object.buyOrderId) : 0,
+      sellOrderId: isSet(object.sellOrderId) ? Number(object.sellOrderId) : 0,
+      time: isSet(object.time) ? fromJsonTimestamp(object.time) : undefined,
+    };
+  },
+
+  toJSON(message: Trade): unknown {
+    const obj: any = {};
+    if (message.id !== 0) {
+      obj.id = Math.round(message.id);
+    }
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.price !== 0) {
+      obj.price = message.price;
+    }
+    if (message.quantity !== 0) {
+      obj.quantity = Math.round(message.quantity);
+    }
+    if (message.buyOrderId !== 0) {
+      obj.buyOrderId = Math.round(message.buyOrderId);
+    }
+    if (message.sellOrderId !== 0) {
+      obj.sellOrderId = Math.round(message.sellOrderId);
+    }
+    if (message.time !== undefined) {
+      obj.time = message.time.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Trade>, I>>(base?: I): Trade {
+    return Trade.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<Trade>, I>>(object: I): Trade {
+    const message = createBaseTrade();
+    message.id = object.id ?? 0;
+    message.code = object.code ?? "";
+    message.price = object.price ?? 0;
+    message.quantity = object.quantity ?? 0;
+    message.buyOrderId = object.buyOrderId ?? 0;
+    message.sellOrderId = object.sellOrderId ?? 0;
+    message.time = object.time ?? undefined;
+    return message;
+  },
+};
+
+function createBaseOrderResult(): OrderResult {
+  return { order: undefined, trades: [] };
+}
+
+export const OrderResult = {
+  encode(message: OrderResult, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.order !== undefined) {
+      Order.encode(message.order, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.trades) {
+      Trade.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OrderResult {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOrderResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.order = Order.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.trades.push(Trade.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): OrderResult {
+    return {
+      order: isSet(object.order) ? Order.fromJSON(object.order) : undefined,
+      trades: Array.isArray(object?.trades) ? object.trades.map((e: any) => Trade.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: OrderResult): unknown {
+    const obj: any = {};
+    if (message.order !== undefined) {
+      obj.order = Order.toJSON(message.order);
+    }
+    if (message.trades?.length) {
+      obj.trades = message.trades.map((e) => Trade.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<OrderResult>, I>>(base?: I): OrderResult {
+    return OrderResult.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<OrderResult>, I>>(object: I): OrderResult {
+    const message = createBaseOrderResult();
+    message.order = (object.order !== undefined && object.order !== null) ? Order.fromPartial(object.order) : undefined;
+    message.trades = object.trades?.map((e) => Trade.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseOrderBookRequest(): OrderBookRequest {
+  return { code: "", depth: 0 };
+}
+
+export const OrderBookRequest = {
+  encode(message: OrderBookRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    if (message.depth !== 0) {
+      writer.uint32(16).int32(message.depth);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OrderBookRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOrderBookRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.depth = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): OrderBookRequest {
+    return {
+      code: isSet(object.code) ? String(object.code) : "",
+      depth: isSet(object.depth) ? Number(object.depth) : 0,
+    };
+  },
+
+  toJSON(message: OrderBookRequest): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.depth !== 0) {
+      obj.depth = Math.round(message.depth);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<OrderBookRequest>, I>>(base?: I): OrderBookRequest {
+    return OrderBookRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<OrderBookRequest>, I>>(object: I): OrderBookRequest {
+    const message = createBaseOrderBookRequest();
+    message.code = object.code ?? "";
+    message.depth = object.depth ?? 0;
+    return message;
+  },
+};
+
+function createBasePriceLevel(): PriceLevel {
+  return { price: 0, quantity: 0, orderCount: 0 };
+}
+
+export const PriceLevel = {
+  encode(message: PriceLevel, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.price !== 0) {
+      writer.uint32(9).double(message.price);
+    }
+    if (message.quantity !== 0) {
+      writer.uint32(16).int64(message.quantity);
+    }
+    if (message.orderCount !== 0) {
+      writer.uint32(24).int32(message.orderCount);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PriceLevel {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePriceLevel();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 9) {
+            break;
+          }
+
+          message.price = reader.double();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.quantity = longToNumber(reader.int64() as Long);
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.orderCount = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PriceLevel {
+    return {
+      price: isSet(object.price) ? Number(object.price) : 0,
+      quantity: isSet(object.quantity) ? Number(object.quantity) : 0,
+      orderCount: isSet(object.orderCount) ? Number(object.orderCount) : 0,
+    };
+  },
+
+  toJSON(message: PriceLevel): unknown {
+    const obj: any = {};
+    if (message.price !== 0) {
+      obj.price = message.price;
+    }
+    if (message.quantity !== 0) {
+      obj.quantity = Math.round(message.quantity);
+    }
+    if (message.orderCount !== 0) {
+      obj.orderCount = Math.round(message.orderCount);
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PriceLevel>, I>>(base?: I): PriceLevel {
+    return PriceLevel.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PriceLevel>, I>>(object: I): PriceLevel {
+    const message = createBasePriceLevel();
+    message.price = object.price ?? 0;
+    message.quantity = object.quantity ?? 0;
+    message.orderCount = object.orderCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseOrderBook(): OrderBook {
+  return { code: "", bids: [], asks: [], lastTrade: undefined, time: undefined };
+}
+
+export const OrderBook = {
+  encode(message: OrderBook, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    for (const v of message.bids) {
+      PriceLevel.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    for (const v of message.asks) {
+      PriceLevel.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.lastTrade !== undefined) {
+      Trade.encode(message.lastTrade, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.time !== undefined) {
+      Timestamp.encode(toTimestamp(message.time), writer.uint32(42).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): OrderBook {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseOrderBook();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.bids.push(PriceLevel.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.asks.push(PriceLevel.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.lastTrade = Trade.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): OrderBook {
+    return {
+      code: isSet(object.code) ? String(object.code) : "",
+      bids: Array.isArray(object?.bids) ? object.bids.map((e: any) => PriceLevel.fromJSON(e)) : [],
+      asks: Array.isArray(object?.asks) ? object.asks.map((e: any) => PriceLevel.fromJSON(e)) : [],
+      lastTrade: isSet(object.lastTrade) ? Trade.fromJSON(object.lastTrade) : undefined,
+      time: isSet(object.time) ? fromJsonTimestamp(object.time) : undefined,
+    };
+  },
+
+  toJSON(message: OrderBook): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.bids?.length) {
+      obj.bids = message.bids.map((e) => PriceLevel.toJSON(e));
+    }
+    if (message.asks?.length) {
+      obj.asks = message.asks.map((e) => PriceLevel.toJSON(e));
+    }
+    if (message.lastTrade !== undefined) {
+      obj.lastTrade = Trade.toJSON(message.lastTrade);
+    }
+    if (message.time !== undefined) {
+      obj.time = message.time.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<OrderBook>, I>>(base?: I): OrderBook {
+    return OrderBook.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<OrderBook>, I>>(object: I): OrderBook {
+    const message = createBaseOrderBook();
+    message.code = object.code ?? "";
+    message.bids = object.bids?.map((e) => PriceLevel.fromPartial(e)) || [];
+    message.asks = object.asks?.map((e) => PriceLevel.fromPartial(e)) || [];
+    message.lastTrade = (object.lastTrade !== undefined && object.lastTrade !== null)
+      ? Trade.fromPartial(object.lastTrade)
+      : undefined;
+    message.time = object.time ?? undefined;
+    return message;
+  },
+};
+
+function createBaseAccountRequest(): AccountRequest {
+  return { accountId: "" };
+}
+
+export const AccountRequest = {
+  encode(message: AccountRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.accountId !== "") {
+      writer.uint32(10).string(message.accountId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AccountRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAccountRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.accountId = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AccountRequest {
+    return { accountId: isSet(object.accountId) ? String(object.accountId) : "" };
+  },
+
+  toJSON(message: AccountRequest): unknown {
+    const obj: any = {};
+    if (message.accountId !== "") {
+      obj.accountId = message.accountId;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<AccountRequest>, I>>(base?: I): AccountRequest {
+    return AccountRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<AccountRequest>, I>>(object: I): AccountRequest {
+    const message = createBaseAccountRequest();
+    message.accountId = object.accountId ?? "";
+    return message;
+  },
+};
+
+function createBaseCashDeposit(): CashDeposit {
+  return { accountId: "", amount: 0, memo: "" };
+}
+
+export const CashDeposit = {
+  encode(message: CashDeposit, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.accountId !== "") {
+      writer.uint32(10).string(message.accountId);
+    }
+    if (message.amount !== 0) {
+      writer.uint32(17).double(message.amount);
+    }
+    if (message.memo !== "") {
+      writer.uint32(26).string(message.memo);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CashDeposit {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCashDeposit();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.accountId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 17) {
+            break;
+          }
+
+          message.amount = reader.double();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.memo = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CashDeposit {
+    return {
+      accountId: isSet(object.accountId) ? String(object.accountId) : "",
+      amount: isSet(object.amount) ? Number(object.amount) : 0,
+      memo: isSet(object.memo) ? String(object.memo) : "",
+    };
+  },
+
+  toJSON(message: CashDeposit): unknown {
+    const obj: any = {};
+    if (message.accountId !== "") {
+      obj.accountId = message.accountId;
+    }
+    if (message.amount !== 0) {
+      obj.amount = message.amount;
+    }
+    if (message.memo !== "") {
+      obj.memo = message.memo;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<CashDeposit>, I>>(base?: I): CashDeposit {
+    return CashDeposit.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<CashDeposit>, I>>(object: I): CashDeposit {
+    const message = createBaseCashDeposit();
+    message.accountId = object.accountId ?? "";
+    message.amount = object.amount ?? 0;
+    message.memo = object.memo ?? "";
+    return message;
+  },
+};
+
+function createBaseBalance(): Balance {
+  return { accountId: "", cash: 0, reserved: 0, buyingPower: 0 };
+}
+
+export const Balance = {
+  encode(message: Balance, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.accountId !== "") {
+      writer.uint32(10).string(message.accountId);
+    }
+    if (message.cash !== 0) {
+      writer.uint32(17).double(message.cash);
+    }
+    if (message.reserved !== 0) {
+      writer.uint32(25).double(message.reserved);
+    }
+    if (message.buyingPower !== 0) {
+      writer.uint32(33).double(message.buyingPower);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Balance {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBalance();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.accountId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 17) {
+            break;
+          }
+
+          message.cash = reader.double();
+          continue;
+        case 3:
+          if (tag !== 25) {
+            break;
+          }
+
+          message.reserved = reader.double();
+          continue;
+        case 4:
+          if (tag !== 33) {
+            break;
+          }
+
+          message.buyingPower = reader.double();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Balance {
+    return {
+      accountId: isSet(object.accountId) ? String(object.accountId) : "",
+      cash: isSet(object.cash) ? Number(object.cash) : 0,
+      reserved: isSet(object.reserved) ? Number(object.reserved) : 0,
+      buyingPower: isSet(object.buyingPower) ? Number(object.buyingPower) : 0,
+    };
+  },
+
+  toJSON(message: Balance): unknown {
+    const obj: any = {};
+    if (message.accountId !== "") {
+      obj.accountId = message.accountId;
+    }
+    if (message.cash !== 0) {
+      obj.cash = message.cash;
+    }
+    if (message.reserved !== 0) {
+      obj.reserved = message.reserved;
+    }
+    if (message.buyingPower !== 0) {
+      obj.buyingPower = message.buyingPower;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Balance>, I>>(base?: I): Balance {
+    return Balance.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<Balance>, I>>(object: I): Balance {
+    const message = createBaseBalance();
+    message.accountId = object.accountId ?? "";
+    message.cash = object.cash ?? 0;
+    message.reserved = object.reserved ?? 0;
+    message.buyingPower = object.buyingPower ?? 0;
+    return message;
+  },
+};
+
+function createBasePosition(): Position {
+  return {
+    code: "",
+    quantity: 0,
+    reservedQuantity: 0,
+    averageCost: 0,
+    marketPrice: 0,
+    marketValue: 0,
+    unrealizedPnl: 0,
+    realizedPnl: 0,
+  };
+}
+
+export const Position = {
+  encode(message: Position, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== "") {
+      writer.uint32(10).string(message.code);
+    }
+    if (message.quantity !== 0) {
+      writer.uint32(16).int64(message.quantity);
+    }
+    if (message.reservedQuantity !== 0) {
+      writer.uint32(24).int64(message.reservedQuantity);
+    }
+    if (message.averageCost !== 0) {
+      writer.uint32(33).double(message.averageCost);
+    }
+    if (message.marketPrice !== 0) {
+      writer.uint32(41).double(message.marketPrice);
+    }
+    if (message.marketValue !== 0) {
+      writer.uint32(49).double(message.marketValue);
+    }
+    if (message.unrealizedPnl !== 0) {
+      writer.uint32(57).double(message.unrealizedPnl);
+    }
+    if (message.realizedPnl !== 0) {
+      writer.uint32(65).double(message.realizedPnl);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Position {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePosition();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.code = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.quantity = longToNumber(reader.int64() as Long);
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.reservedQuantity = longToNumber(reader.int64() as Long);
+          continue;
+        case 4:
+          if (tag !== 33) {
+            break;
+          }
+
+          message.averageCost = reader.double();
+          continue;
+        case 5:
+          if (tag !== 41) {
+            break;
+          }
+
+          message.marketPrice = reader.double();
+          continue;
+        case 6:
+          if (tag !== 49) {
+            break;
+          }
+
+          message.marketValue = reader.double();
+          continue;
+        case 7:
+          if (tag !== 57) {
+            break;
+          }
+
+          message.unrealizedPnl = reader.double();
+          continue;
+        case 8:
+          if (tag !== 65) {
+            break;
+          }
+
+          message.realizedPnl = reader.double();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Position {
+    return {
+      code: isSet(object.code) ? String(object.code) : "",
+      quantity: isSet(object.quantity) ? Number(object.quantity) : 0,
+      reservedQuantity: isSet(object.reservedQuantity) ? Number(object.reservedQuantity) : 0,
+      averageCost: isSet(object.averageCost) ? Number(object.averageCost) : 0,
+      marketPrice: isSet(object.marketPrice) ? Number(object.marketPrice) : 0,
+      marketValue: isSet(object.marketValue) ? Number(object.marketValue) : 0,
+      unrealizedPnl: isSet(object.unrealizedPnl) ? Number(object.unrealizedPnl) : 0,
+      realizedPnl: isSet(object.realizedPnl) ? Number(object.realizedPnl) : 0,
+    };
+  },
+
+  toJSON(message: Position): unknown {
+    const obj: any = {};
+    if (message.code !== "") {
+      obj.code = message.code;
+    }
+    if (message.quantity !== 0) {
+      obj.quantity = Math.round(message.quantity);
+    }
+    if (message.reservedQuantity !== 0) {
+      obj.reservedQuantity = Math.round(message.reservedQuantity);
+    }
+    if (message.averageCost !== 0) {
+      obj.averageCost = message.averageCost;
+    }
+    if (message.marketPrice !== 0) {
+      obj.marketPrice = message.marketPrice;
+    }
+    if (message.marketValue !== 0) {
+      obj.marketValue = message.marketValue;
+    }
+    if (message.unrealizedPnl !== 0) {
+      obj.unrealizedPnl = message.unrealizedPnl;
+    }
+    if (message.realizedPnl !== 0) {
+      obj.realizedPnl = message.realizedPnl;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<Position>, I>>(base?: I): Position {
+    return Position.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<Position>, I>>(object: I): Position {
+    const message = createBasePosition();
+    message.code = object.code ?? "";
+    message.quantity = object.quantity ?? 0;
+    message.reservedQuantity = object.reservedQuantity ?? 0;
+    message.averageCost = object.averageCost ?? 0;
+    message.marketPrice = object.marketPrice ?? 0;
+    message.marketValue = object.marketValue ?? 0;
+    message.unrealizedPnl = object.unrealizedPnl ?? 0;
+    message.realizedPnl = object.realizedPnl ?? 0;
+    return message;
+  },
+};
+
+function createBasePositionList(): PositionList {
+  return { accountId: "", positionList: [] };
+}
+
+export const PositionList = {
+  encode(message: PositionList, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.accountId !== "") {
+      writer.uint32(10).string(message.accountId);
+    }
+    for (const v of message.positionList) {
+      Position.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PositionList {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePositionList();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.accountId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.positionList.push(Position.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PositionList {
+    return {
+      accountId: isSet(object.accountId) ? String(object.accountId) : "",
+      positionList: Array.isArray(object?.positionList)
+        ? object.positionList.map((e: any) => Position.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: PositionList): unknown {
+    const obj: any = {};
+    if (message.accountId !== "") {
+      obj.accountId = message.accountId;
+    }
+    if (message.positionList?.length) {
+      obj.positionList = message.positionList.map((e) => Position.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PositionList>, I>>(base?: I): PositionList {
+    return PositionList.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PositionList>, I>>(object: I): PositionList {
+    const message = createBasePositionList();
+    message.accountId = object.accountId ?? "";
+    message.positionList = object.positionList?.map((e) => Position.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBasePnL(): PnL {
+  return { accountId: "", realized: 0, unrealized: 0, total: 0, equity: 0 };
+}
+
+export const PnL = {
+  encode(message: PnL, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.accountId !== "") {
+      writer.uint32(10).string(message.accountId);
+    }
+    if (message.realized !== 0) {
+      writer.uint32(17).double(message.realized);
+    }
+    if (message.unrealized !== 0) {
+      writer.uint32(25).double(message.unrealized);
+    }
+    if (message.total !== 0) {
+      writer.uint32(33).double(message.total);
+    }
+    if (message.equity !== 0) {
+      writer.uint32(41).double(message.equity);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PnL {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePnL();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.accountId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 17) {
+            break;
+          }
+
+          message.realized = reader.double();
+          continue;
+        case 3:
+          if (tag !== 25) {
+            break;
+          }
+
+          message.unrealized = reader.double();
+          continue;
+        case 4:
+          if (tag !== 33) {
+            break;
+          }
+
+          message.total = reader.double();
+          continue;
+        case 5:
+          if (tag !== 41) {
+            break;
+          }
+
+          message.equity = reader.double();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PnL {
+    return {
+      accountId: isSet(object.accountId) ? String(object.accountId) : "",
+      realized: isSet(object.realized) ? Number(object.realized) : 0,
+      unrealized: isSet(object.unrealized) ? Number(object.unrealized) : 0,
+      total: isSet(object.total) ? Number(object.total) : 0,
+      equity: isSet(object.equity) ? Number(object.equity) : 0,
+    };
+  },
+
+  toJSON(message: PnL): unknown {
+    const obj: any = {};
+    if (message.accountId !== "") {
+      obj.accountId = message.accountId;
+    }
+    if (message.realized !== 0) {
+      obj.realized = message.realized;
+    }
+    if (message.unrealized !== 0) {
+      obj.unrealized = message.unrealized;
+    }
+    if (message.total !== 0) {
+      obj.total = message.total;
+    }
+    if (message.equity !== 0) {
+      obj.equity = message.equity;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<PnL>, I>>(base?: I): PnL {
+    return PnL.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<PnL>, I>>(object: I): PnL {
+    const message = createBasePnL();
+    message.accountId = object.accountId ?? "";
+    message.realized = object.realized ?? 0;
+    message.unrealized = object.unrealized ?? 0;
+    message.total = object.total ?? 0;
+    message.equity = object.equity ?? 0;
+    return message;
+  },
+};
+
+function createBaseMarketSession(): MarketSession {
+  return { state: 0, time: undefined, nextState: 0, nextAt: undefined };
+}
+
+export const MarketSession = {
+  encode(message: MarketSession, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.state !== 0) {
+      writer.uint32(8).int32(message.state);
+    }
+    if (message.time !== undefined) {
+      Timestamp.encode(toTimestamp(message.time), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.nextState !== 0) {
+      writer.uint32(24).int32(message.nextState);
+    }
+    if (message.nextAt !== undefined) {
+      Timestamp.encode(toTimestamp(message.nextAt), writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MarketSession {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMarketSession();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.state = reader.int32() as any;
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.nextState = reader.int32() as any;
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.nextAt = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MarketSession {
+    return {
+      state: isSet(object.state) ? sessionStateFromJSON(object.state) : 0,
+      time: isSet(object.time) ? fromJsonTimestamp(object.time) : undefined,
+      nextState: isSet(object.nextState) ? sessionStateFromJSON(object.nextState) : 0,
+      nextAt: isSet(object.nextAt) ? fromJsonTimestamp(object.nextAt) : undefined,
+    };
+  },
+
+  toJSON(message: MarketSession): unknown {
+    const obj: any = {};
+    if (message.state !== 0) {
+      obj.state = sessionStateToJSON(message.state);
+    }
+    if (message.time !== undefined) {
+      obj.time = message.time.toISOString();
+    }
+    if (message.nextState !== 0) {
+      obj.nextState = sessionStateToJSON(message.nextState);
+    }
+    if (message.nextAt !== undefined) {
+      obj.nextAt = message.nextAt.toISOString();
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<MarketSession>, I>>(base?: I): MarketSession {
+    return MarketSession.fromPartial(base ?? {});
+  },
+
+  fromPartial<I extends Exact<DeepPartial<MarketSession>, I>>(object: I): MarketSession {
+    const message = createBaseMarketSession();
+    message.state = object.state ?? 0;
+    message.time = object.time ?? undefined;
+    message.nextState = object.nextState ?? 0;
+    message.nextAt = object.nextAt ?? undefined;
+    return message;
+  },
+};
+
+export type TradingService = typeof TradingService;
+export const TradingService = {
+  placeOrder: {
+    path: "/trading.Trading/PlaceOrder",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: NewOrder) => Buffer.from(NewOrder.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => NewOrder.decode(value),
+    responseSerialize: (value: OrderResult) => Buffer.from(OrderResult.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => OrderResult.decode(value),
+  },
+  cancelOrder: {
+    path: "/trading.Trading/CancelOrder",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: OrderId) => Buffer.from(OrderId.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => OrderId.decode(value),
+    responseSerialize: (value: Order) => Buffer.from(Order.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Order.decode(value),
+  },
+  replaceOrder: {
+    path: "/trading.Trading/ReplaceOrder",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: OrderReplacement) => Buffer.from(OrderReplacement.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => OrderReplacement.decode(value),
+    responseSerialize: (value: OrderResult) => Buffer.from(OrderResult.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => OrderResult.decode(value),
+  },
+  streamOrderBook: {
+    path: "/trading.Trading/StreamOrderBook",
+    requestStream: false,
+    responseStream: true,
+    requestSerialize: (value: OrderBookRequest) => Buffer.from(OrderBookRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => OrderBookRequest.decode(value),
+    responseSerialize: (value: OrderBook) => Buffer.from(OrderBook.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => OrderBook.decode(value),
+  },
+  /**
+   * StreamOrders sends every change of the caller's orders, including the
+   * cancellations made by a stock split.
+   */
+  streamOrders: {
+    path: "/trading.Trading/StreamOrders",
+    requestStream: false,
+    responseStream: true,
+    requestSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => Empty.decode(value),
+    responseSerialize: (value: Order) => Buffer.from(Order.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Order.decode(value),
+  },
+  deposit: {
+    path: "/trading.Trading/Deposit",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: CashDeposit) => Buffer.from(CashDeposit.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => CashDeposit.decode(value),
+    responseSerialize: (value: Balance) => Buffer.from(Balance.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Balance.decode(value),
+  },
+  getBalance: {
+    path: "/trading.Trading/GetBalance",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: AccountRequest) => Buffer.from(AccountRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => AccountRequest.decode(value),
+    responseSerialize: (value: Balance) => Buffer.from(Balance.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => Balance.decode(value),
+  },
+  listPositions: {
+    path: "/trading.Trading/ListPositions",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: AccountRequest) => Buffer.from(AccountRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => AccountRequest.decode(value),
+    responseSerialize: (value: PositionList) => Buffer.from(PositionList.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => PositionList.decode(value),
+  },
+  getPnL: {
+    path: "/trading.Trading/GetPnL",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: AccountRequest) => Buffer.from(AccountRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => AccountRequest.decode(value),
+    responseSerialize: (value: PnL) => Buffer.from(PnL.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => PnL.decode(value),
+  },
+  getMarketSession: {
+    path: "/trading.Trading/GetMarketSession",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => Empty.decode(value),
+    responseSerialize: (value: MarketSession) => Buffer.from(MarketSession.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => MarketSession.decode(value),
+  },
+  streamMarketSessions: {
+    path: "/trading.Trading/StreamMarketSessions",
+    requestStream: false,
+    responseStream: true,
+    requestSerialize: (value: Empty) => Buffer.from(Empty.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => Empty.decode(value),
+    responseSerialize: (value: MarketSession) => Buffer.from(MarketSession.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => MarketSession.decode(value),
+  },
+} as const;
+
+export interface TradingServer extends UntypedServiceImplementation {
+  placeOrder: handleUnaryCall<NewOrder, OrderResult>;
+  cancelOrder: handleUnaryCall<OrderId, Order>;
+  replaceOrder: handleUnaryCall<OrderReplacement, OrderResult>;
+  streamOrderBook: handleServerStreamingCall<OrderBookRequest, OrderBook>;
+  /**
+   * StreamOrders sends every change of the caller's orders, including the
+   * cancellations made by a stock split.
+   */
+  streamOrders: handleServerStreamingCall<Empty, Order>;
+  deposit: handleUnaryCall<CashDeposit, Balance>;
+  getBalance: handleUnaryCall<AccountRequest, Balance>;
+  listPositions: handleUnaryCall<AccountRequest, PositionList>;
+  getPnL: handleUnaryCall<AccountRequest, PnL>;
+  getMarketSession: handleUnaryCall<Empty, MarketSession>;
+  streamMarketSessions: handleServerStreamingCall<Empty, MarketSession>;
+}
+
+export interface TradingClient extends Client {
+  placeOrder(request: NewOrder, callback: (error: ServiceError | null, response: OrderResult) => void): ClientUnaryCall;
+  placeOrder(
+    request: NewOrder,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: OrderResult) => void,
+  ): ClientUnaryCall;
+  placeOrder(
+    request: NewOrder,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: OrderResult) => void,
+  ): ClientUnaryCall;
+  cancelOrder(request: OrderId, callback: (error: ServiceError | null, response: Order) => void): ClientUnaryCall;
+  cancelOrder(
+    request: OrderId,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Order) => void,
+  ): ClientUnaryCall;
+  cancelOrder(
+    request: OrderId,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Order) => void,
+  ): ClientUnaryCall;
+  replaceOrder(
+    request: OrderReplacement,
+    callback: (error: ServiceError | null, response: OrderResult) => void,
+  ): ClientUnaryCall;
+  replaceOrder(
+    request: OrderReplacement,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: OrderResult) => void,
+  ): ClientUnaryCall;
+  replaceOrder(
+    request: OrderReplacement,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: OrderResult) => void,
+  ): ClientUnaryCall;
+  streamOrderBook(request: OrderBookRequest, options?: Partial<CallOptions>): ClientReadableStream<OrderBook>;
+  streamOrderBook(
+    request: OrderBookRequest,
+    metadata?: Metadata,
+    options?: Partial<CallOptions>,
+  ): ClientReadableStream<OrderBook>;
+  /**
+   * StreamOrders sends every change of the caller's orders, including the
+   * cancellations made by a stock split.
+   */
+  streamOrders(request: Empty, options?: Partial<CallOptions>): ClientReadableStream<Order>;
+  streamOrders(request: Empty, metadata?: Metadata, options?: Partial<CallOptions>): ClientReadableStream<Order>;
+  deposit(request: CashDeposit, callback: (error: ServiceError | null, response: Balance) => void): ClientUnaryCall;
+  deposit(
+    request: CashDeposit,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Balance) => void,
+  ): ClientUnaryCall;
+  deposit(
+    request: CashDeposit,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Balance) => void,
+  ): ClientUnaryCall;
+  getBalance(
+    request: AccountRequest,
+    callback: (error: ServiceError | null, response: Balance) => void,
+  ): ClientUnaryCall;
+  getBalance(
+    request: AccountRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: Balance) => void,
+  ): ClientUnaryCall;
+  getBalance(
+    request: AccountRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: Balance) => void,
+  ): ClientUnaryCall;
+  listPositions(
+    request: AccountRequest,
+    callback: (error: ServiceError | null, response: PositionList) => void,
+  ): ClientUnaryCall;
+  listPositions(
+    request: AccountRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: PositionList) => void,
+  ): ClientUnaryCall;
+  listPositions(
+    request: AccountRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: PositionList) => void,
+  ): ClientUnaryCall;
+  getPnL(request: AccountRequest, callback: (error: ServiceError | null, response: PnL) => void): ClientUnaryCall;
+  getPnL(
+    request: AccountRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: PnL) => void,
+  ): ClientUnaryCall;
+  getPnL(
+    request: AccountRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: PnL) => void,
+  ): ClientUnaryCall;
+  getMarketSession(
+    request: Empty,
+    callback: (error: ServiceError | null, response: MarketSession) => void,
+  ): ClientUnaryCall;
+  getMarketSession(
+    request: Empty,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: MarketSession) => void,
+  ): ClientUnaryCall;
+  getMarketSession(
+    request: Empty,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: MarketSession) => void,
+  ): ClientUnaryCall;
+  streamMarketSessions(request: Empty, options?: Partial<CallOptions>): ClientReadableStream<MarketSession>;
+  streamMarketSessions(
+    request: Empty,
+    metadata?: Metadata,
+    options?: Partial<CallOptions>,
+  ): ClientReadableStream<MarketSession>;
+}
+
+export const TradingClient = makeGenericClientConstructor(TradingService, "trading.Trading") as unknown as {
+  new (address: string, credentials: ChannelCredentials, options?: Partial<ClientOptions>): TradingClient;
+  service: typeof TradingService;
+};
+
+declare const self: any | undefined;
+declare const window: any | undefined;
+declare const global: any | undefined;
+const tsProtoGlobalThis: any = (() => {
+  if (typeof globalThis !== "undefined") {
+    return globalThis;
+  }
+  if (typeof self !== "undefined") {
+    return self;
+  }
+  if (typeof window !== "undefined") {
+    return window;
+  }
+  if (typeof global !== "undefined") {
+    return global;
+  }
+  throw "Unable to locate global object";
+})();
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends Array<infer U> ? Array<DeepPartial<U>> : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+type KeysOfUnion<T> = T extends T ? keyof T : never;
+export type Exact<P, I extends P> = P extends Builtin ? P
+  : P & { [K in keyof P]: Exact<P[K], I[K]> } & { [K in Exclude<keyof I, KeysOfUnion<P>>]: never };
+
+function toTimestamp(date: Date): Timestamp {
+  const seconds = Math.trunc(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function longToNumber(long: Long): number {
+  if (long.gt(Number.MAX_SAFE_INTEGER)) {
+    throw new tsProtoGlobalThis.Error("Value is larger than Number.MAX_SAFE_INTEGER");
+  }
+  return long.toNumber();
+}
+
+if (_m0.util.Long !== Long) {
+  _m0.util.Long = Long as any;
+  _m0.configure();
+}
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
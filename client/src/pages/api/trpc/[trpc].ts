@@ -1,9 +1,10 @@
 import * as trpcNext from '@trpc/server/adapters/next';
 import { appRouter } from '~/server/routers/_app';
+import { createContext } from '~/server/trpc';
 
 // export API handler
 // @see https://trpc.io/docs/server/adapters
 export default trpcNext.createNextApiHandler({
     router: appRouter,
-    createContext: () => ({}),
-});
\ No newline at end of file
+    createContext,
+});
@@ -2,7 +2,7 @@ import {credentials, Metadata} from '@grpc/grpc-js';
 import * as Sentry from '@sentry/nextjs';
 import {z} from 'zod';
 import {BoardClient, Question, Subject} from '~/grpc/board';
-import {procedure, router} from '../trpc';
+import {Context, procedure, router} from '../trpc';
 
 const host = process.env.GRPC_HOST || '127.0.0.1';
 const port = process.env.GRPC_PORT || '9095';
@@ -22,8 +22,18 @@ console.log('GRPC_OPTIONS: ', opts);
 
 const board = new BoardClient(`${host}:${port}`, creds, opts);
 
+// clientMetadata forwards the address of the visitor, which the server
+// trusts from this server only when it is listed in its TRUSTED_PROXIES.
+function clientMetadata(ctx: Context): Metadata {
+    const metadata = new Metadata();
+    if (ctx.forwardedFor) {
+        metadata.set('x-forwarded-for', ctx.forwardedFor);
+    }
+    return metadata;
+}
+
 export const appRouter = router({
-    listSubjects: procedure.query(async (): Promise<Subject[]> => {
+    listSubjects: procedure.query(async ({ctx}): Promise<Subject[]> => {
 
         const parentSpan = Sentry.getCurrentHub().getScope().getSpan();
         const span = parentSpan && parentSpan.startChild({
@@ -33,7 +43,7 @@ export const appRouter = router({
 
         const subjects: Promise<Subject[]> = new Promise((resolve, reject) => {
 
-            const metadata = new Metadata();
+            const metadata = clientMetadata(ctx);
             if (span) {
                 metadata.set("traceid", span.traceId)
                 metadata.set("spanid", span.spanId)
@@ -60,9 +70,9 @@ export const appRouter = router({
         z.object({
             id: z.number(),
         })
-    ).query(async ({input}): Promise<Subject> => {
+    ).query(async ({input, ctx}): Promise<Subject> => {
         const subject: Promise<Subject> = new Promise((resolve, reject) => {
-            board.getSubject(input, (err, subject) => {
+            board.getSubject(input, clientMetadata(ctx), (err, subject) => {
                 if (err) {
                     Sentry.captureException(err)
                     console.error(err);
@@ -80,9 +90,9 @@ export const appRouter = router({
         z.object({
             id: z.number(),
         })
-    ).query(async ({input}): Promise<Question[]> => {
+    ).query(async ({input, ctx}): Promise<Question[]> => {
         const questions: Promise<Question[]> = new Promise((resolve, reject) => {
-            board.listQuestions(input, (err, questionList) => {
+            board.listQuestions({subjectId: input.id, tags: [], matchAll: false}, clientMetadata(ctx), (err, questionList) => {
                 if (err) {
                     Sentry.captureException(err)
                     console.error(err);
@@ -101,9 +111,9 @@ export const appRouter = router({
             question: z.string(),
             subjectId: z.number(),
         })
-    ).mutation(async ({input: newQuestion})=> {
+    ).mutation(async ({input: newQuestion, ctx})=> {
         new Promise((resolve, reject) => {
-            board.createQuestion(newQuestion, (err, empty) => {
+            board.createQuestion(newQuestion, clientMetadata(ctx), (err, empty) => {
                 if (err) {
                     Sentry.captureException(err)
                     console.error(err);
@@ -117,9 +127,9 @@ export const appRouter = router({
     like: procedure.input(
         z.object({
             id: z.number(),
-        })).mutation(async ({input}) => {
+        })).mutation(async ({input, ctx}) => {
         new Promise((resolve, reject) => {
-            board.like(input, (err, empty) => {
+            board.like(input, clientMetadata(ctx), (err, empty) => {
                 if (err) {
                     Sentry.captureException(err)
                     console.error(err);
@@ -133,9 +143,9 @@ export const appRouter = router({
     unlike: procedure.input(
         z.object({
             id: z.number(),
-        })).mutation(async ({input}) => {
+        })).mutation(async ({input, ctx}) => {
         new Promise((resolve, reject) => {
-            board.unlike(input, (err, empty) => {
+            board.unlike(input, clientMetadata(ctx), (err, empty) => {
                 if (err) {
                     Sentry.captureException(err)
                     console.error(err);
@@ -1,6 +1,23 @@
 import { initTRPC, TRPCError } from '@trpc/server';
+import type { CreateNextContextOptions } from '@trpc/server/adapters/next';
 
-const t = initTRPC.create();
+// createContext keeps the address chain of the visitor, so the server can
+// tell visitors apart instead of seeing every call come from this server.
+export const createContext = ({req}: CreateNextContextOptions) => {
+    const forwarded = req.headers['x-forwarded-for'];
+    const hops = (Array.isArray(forwarded) ? forwarded : [forwarded])
+        .flatMap((value) => (value || '').split(','))
+        .map((hop) => hop.trim())
+        .filter((hop) => hop !== '');
+    if (req.socket.remoteAddress) {
+        hops.push(req.socket.remoteAddress);
+    }
+    return {forwardedFor: hops.join(', ')};
+};
+
+export type Context = Awaited<ReturnType<typeof createContext>>;
+
+const t = initTRPC.context<Context>().create();
 
 export const router = t.router;
 export const procedure = t.procedure;
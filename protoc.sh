@@ -9,4 +9,7 @@ protoc \
   --plugin protoc-gen-ts_proto=./client/node_modules/.bin/protoc-gen-ts_proto \
   --ts_proto_opt outputServices=grpc-js,env=node,esModuleInterop=true \
   --ts_proto_out ./client/src/grpc \
-  board.proto
+  alert.proto \
+  board.proto \
+  stocks.proto \
+  trading.proto
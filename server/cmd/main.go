@@ -1,17 +1,22 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net"
 	"os"
-	"sync"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	// external packages
 	"github.com/getsentry/sentry-go"
 	sentryfasthttp "github.com/getsentry/sentry-go/fasthttp"
 	_ "github.com/lib/pq"
+	"github.com/redis/go-redis/v9"
 	log "github.com/sirupsen/logrus"
 	"github.com/valyala/fasthttp"
 
@@ -35,7 +40,9 @@ func init() {
 }
 
 func main() {
-	log.SetLevel(log.TraceLevel)
+	if err := initLogger(); err != nil {
+		log.Fatalf("failed to initialize logger: %v", err)
+	}
 
 	if err := initSentry(); err != nil {
 		log.Fatalf("failed to initialize sentry: %v", err)
@@ -49,15 +56,80 @@ func main() {
 	}
 	defer db.Close()
 
-	var wg sync.WaitGroup
-	wg.Add(2)
+	if len(os.Args) > 1 {
+		if err := runCommand(db, os.Args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	config, err := loadGrpcConfig()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if config.Replica, err = openReplica(); err != nil {
+		log.Fatal(err)
+	}
+	if config.Replica != nil {
+		defer config.Replica.Close()
+	}
+
+	candleFlushInterval, err := time.ParseDuration(getEnvValue("CANDLE_FLUSH_INTERVAL", "10s"))
+	if err != nil {
+		log.Fatalf("invalid CANDLE_FLUSH_INTERVAL: %v", err)
+	}
+	log.Infoln("CANDLE_FLUSH_INTERVAL: ", candleFlushInterval)
+
+	candles := NewCandleAggregator(db)
+	if candleFlushInterval > 0 {
+		go candles.Run(candleFlushInterval)
+	}
+
+	likeFlushInterval, err := time.ParseDuration(getEnvValue("LIKE_FLUSH_INTERVAL", "1s"))
+	if err != nil {
+		log.Fatalf("invalid LIKE_FLUSH_INTERVAL: %v", err)
+	}
+	likeFlushThreshold, err := strconv.Atoi(getEnvValue("LIKE_FLUSH_THRESHOLD", "1000"))
+	if err != nil {
+		log.Fatalf("invalid LIKE_FLUSH_THRESHOLD: %v", err)
+	}
+	likeBufferCapacity, err := strconv.Atoi(getEnvValue("LIKE_BUFFER_CAPACITY", "100000"))
+	if err != nil {
+		log.Fatalf("invalid LIKE_BUFFER_CAPACITY: %v", err)
+	}
+	log.Infoln("LIKE_FLUSH_INTERVAL: ", likeFlushInterval)
+	log.Infoln("LIKE_FLUSH_THRESHOLD: ", likeFlushThreshold)
+	log.Infoln("LIKE_BUFFER_CAPACITY: ", likeBufferCapacity)
+
+	shutdownTimeout, err := time.ParseDuration(getEnvValue("SHUTDOWN_TIMEOUT", "10s"))
+	if err != nil {
+		log.Fatalf("invalid SHUTDOWN_TIMEOUT: %v", err)
+	}
+	log.Infoln("SHUTDOWN_TIMEOUT: ", shutdownTimeout)
+
+	// a zero interval writes every like straight to the database
+	var likes *LikeBuffer
+	if likeFlushInterval > 0 {
+		likes = NewLikeBuffer(db, likeFlushThreshold, likeBufferCapacity)
+	}
+
+	grpc, err := NewGrpcServer(db, config, candles, likes)
+	if err != nil {
+		sentry.CaptureException(err)
+		log.Fatalf("failed to create gRPC server: %v", err)
+	}
+	if likes != nil {
+		go likes.Run(likeFlushInterval)
+	}
+
 	go func() {
 		port := 8080
 		addr := fmt.Sprintf(":%d", port)
 
-		rest := NewRestServer()
+		rest := NewRestServer(candles)
 		sentryHandler := sentryfasthttp.New(sentryfasthttp.Options{})
-		fastHTTPHandler := sentryHandler.Handle(rest.Handler)
+		fastHTTPHandler := sentryHandler.Handle(RequestId(AccessLog(rest.Handler)))
 
 		log.Printf("run RESTful server on port %d", port)
 
@@ -77,8 +149,6 @@ func main() {
 			log.Fatalf("failed to listen: %v", err)
 		}
 
-		grpc := NewGrpcServer(db)
-
 		log.Printf("run gRPC server on port %d", port)
 		if err := grpc.Serve(listen); err != nil {
 			sentry.CaptureException(err)
@@ -86,7 +156,45 @@ func main() {
 		}
 	}()
 
-	wg.Wait()
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	log.Infof("received %s, shutting down", <-signals)
+
+	// likes are refused and flushed first, since open streams can keep
+	// GracefulStop waiting until the deadline
+	if likes != nil {
+		if err := likes.Close(); err != nil {
+			sentry.CaptureException(err)
+			log.Errorf("failed to flush likes: %v", err)
+		}
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		grpc.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(shutdownTimeout):
+		log.Warnf("gRPC server did not stop within %s, closing open calls", shutdownTimeout)
+		grpc.Stop()
+	}
+	if err := candles.Flush(); err != nil {
+		sentry.CaptureException(err)
+		log.Errorf("failed to flush candles: %v", err)
+	}
+}
+
+func runCommand(db *sql.DB, args []string) error {
+	switch args[0] {
+	case "stocks":
+		return runStocksCommand(db, args[1:])
+	case "token":
+		return runTokenCommand(args[1:])
+	default:
+		return fmt.Errorf("unknown command '%s'", args[0])
+	}
 }
 
 func openDatabase() (*sql.DB, error) {
@@ -123,7 +231,353 @@ func openDatabase() (*sql.DB, error) {
 	log.Infoln("PG_SSLMODE: ", ssl)
 
 	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s", host, port, user, pw, db, ssl)
-	return sql.Open("postgres", dsn)
+	conn, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := configurePool(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// openReplica opens the read replica at PG_REPLICA_DSN with the same pool
+// limits as the primary. It returns nil when no replica is set.
+func openReplica() (*sql.DB, error) {
+	dsn := getEnvValue("PG_REPLICA_DSN", "")
+	log.Infoln("PG_REPLICA_DSN: ", dsn != "")
+	if dsn == "" {
+		return nil, nil
+	}
+
+	conn, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PG_REPLICA_DSN: %w", err)
+	}
+
+	if err := configurePool(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// configurePool applies the PG_* pool limits. Zero means no limit for the
+// open connections and lifetimes.
+func configurePool(db *sql.DB) error {
+	maxOpen, err := strconv.Atoi(getEnvValue("PG_MAX_OPEN_CONNS", "0"))
+	if err != nil {
+		return fmt.Errorf("invalid PG_MAX_OPEN_CONNS: %w", err)
+	}
+	maxIdle, err := strconv.Atoi(getEnvValue("PG_MAX_IDLE_CONNS", "10"))
+	if err != nil {
+		return fmt.Errorf("invalid PG_MAX_IDLE_CONNS: %w", err)
+	}
+	maxLifetime, err := time.ParseDuration(getEnvValue("PG_CONN_MAX_LIFETIME", "30m"))
+	if err != nil {
+		return fmt.Errorf("invalid PG_CONN_MAX_LIFETIME: %w", err)
+	}
+	maxIdleTime, err := time.ParseDuration(getEnvValue("PG_CONN_MAX_IDLE_TIME", "5m"))
+	if err != nil {
+		return fmt.Errorf("invalid PG_CONN_MAX_IDLE_TIME: %w", err)
+	}
+
+	log.Infoln("PG_MAX_OPEN_CONNS: ", maxOpen)
+	log.Infoln("PG_MAX_IDLE_CONNS: ", maxIdle)
+	log.Infoln("PG_CONN_MAX_LIFETIME: ", maxLifetime)
+	log.Infoln("PG_CONN_MAX_IDLE_TIME: ", maxIdleTime)
+
+	db.SetMaxOpenConns(maxOpen)
+	db.SetMaxIdleConns(maxIdle)
+	db.SetConnMaxLifetime(maxLifetime)
+	db.SetConnMaxIdleTime(maxIdleTime)
+
+	return nil
+}
+
+func loadGrpcConfig() (Config, error) {
+	editWindow, err := time.ParseDuration(getEnvValue("QUESTION_EDIT_WINDOW", "5m"))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid QUESTION_EDIT_WINDOW: %w", err)
+	}
+
+	scheduleInterval, err := time.ParseDuration(getEnvValue("SUBJECT_SCHEDULE_INTERVAL", "5s"))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid SUBJECT_SCHEDULE_INTERVAL: %w", err)
+	}
+
+	var moderators []string
+	for _, m := range strings.Split(getEnvValue("BOARD_MODERATORS", ""), ",") {
+		if m = strings.TrimSpace(m); m != "" {
+			moderators = append(moderators, m)
+		}
+	}
+
+	authSecret := getEnvValue("AUTH_SECRET", "")
+	if authSecret == "" {
+		log.Warnln("AUTH_SECRET is empty, every caller is anonymous")
+	}
+
+	log.Infoln("QUESTION_EDIT_WINDOW: ", editWindow)
+	log.Infoln("BOARD_MODERATORS: ", moderators)
+	log.Infoln("SUBJECT_SCHEDULE_INTERVAL: ", scheduleInterval)
+
+	priceFeed, err := loadPriceFeedConfig()
+	if err != nil {
+		return Config{}, err
+	}
+
+	initialCash, err := strconv.ParseFloat(getEnvValue("ACCOUNT_INITIAL_CASH", "10000000"), 64)
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid ACCOUNT_INITIAL_CASH: %w", err)
+	}
+	log.Infoln("ACCOUNT_INITIAL_CASH: ", initialCash)
+
+	corporateActionInterval, err := time.ParseDuration(getEnvValue("CORPORATE_ACTION_INTERVAL", "1m"))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid CORPORATE_ACTION_INTERVAL: %w", err)
+	}
+	log.Infoln("CORPORATE_ACTION_INTERVAL: ", corporateActionInterval)
+
+	var calendar *MarketCalendar
+	if path := getEnvValue("MARKET_CALENDAR_FILE", ""); path != "" {
+		if calendar, err = LoadMarketCalendar(path); err != nil {
+			return Config{}, fmt.Errorf("invalid MARKET_CALENDAR_FILE: %w", err)
+		}
+	}
+	log.Infoln("MARKET_CALENDAR_FILE: ", getEnvValue("MARKET_CALENDAR_FILE", ""))
+
+	boardCache, err := loadBoardCache()
+	if err != nil {
+		return Config{}, err
+	}
+
+	rateLimiter, rateLimits, err := loadRateLimits()
+	if err != nil {
+		return Config{}, err
+	}
+
+	trustedProxies, err := ParseTrustedProxies(getEnvValue("TRUSTED_PROXIES", ""))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid TRUSTED_PROXIES: %w", err)
+	}
+	log.Infoln("TRUSTED_PROXIES: ", getEnvValue("TRUSTED_PROXIES", ""))
+
+	replicaWindow, err := time.ParseDuration(getEnvValue("REPLICA_READ_YOUR_WRITES", "2s"))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid REPLICA_READ_YOUR_WRITES: %w", err)
+	}
+	replicaHealthInterval, err := time.ParseDuration(getEnvValue("REPLICA_HEALTH_INTERVAL", "5s"))
+	if err != nil || replicaHealthInterval <= 0 {
+		return Config{}, fmt.Errorf("invalid REPLICA_HEALTH_INTERVAL: %s", getEnvValue("REPLICA_HEALTH_INTERVAL", "5s"))
+	}
+	log.Infoln("REPLICA_READ_YOUR_WRITES: ", replicaWindow)
+	log.Infoln("REPLICA_HEALTH_INTERVAL: ", replicaHealthInterval)
+
+	purgeInterval, err := time.ParseDuration(getEnvValue("PURGE_INTERVAL", "1h"))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid PURGE_INTERVAL: %w", err)
+	}
+	purgeRetention, err := time.ParseDuration(getEnvValue("PURGE_RETENTION", "720h"))
+	if err != nil || purgeRetention < 0 {
+		return Config{}, fmt.Errorf("invalid PURGE_RETENTION: %s", getEnvValue("PURGE_RETENTION", "720h"))
+	}
+	log.Infoln("PURGE_INTERVAL: ", purgeInterval)
+	log.Infoln("PURGE_RETENTION: ", purgeRetention)
+
+	return Config{
+		EditWindow:       editWindow,
+		AuthSecret:       []byte(authSecret),
+		Moderators:       moderators,
+		ScheduleInterval: scheduleInterval,
+		PriceFeed:        priceFeed,
+		InitialCash:      initialCash,
+
+		CorporateActionInterval: corporateActionInterval,
+		Calendar:                calendar,
+		BoardCache:              boardCache,
+		RateLimiter:             rateLimiter,
+		RateLimits:              rateLimits,
+		TrustedProxies:          trustedProxies,
+
+		ReplicaWindow:         replicaWindow,
+		ReplicaHealthInterval: replicaHealthInterval,
+
+		PurgeInterval:  purgeInterval,
+		PurgeRetention: purgeRetention,
+	}, nil
+}
+
+// loadBoardCache returns nil when BOARD_CACHE is empty, which reads every
+// list straight from the database.
+func loadBoardCache() (Cache, error) {
+	kind := getEnvValue("BOARD_CACHE", "")
+	ttl, err := time.ParseDuration(getEnvValue("BOARD_CACHE_TTL", "5s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid BOARD_CACHE_TTL: %w", err)
+	}
+
+	log.Infoln("BOARD_CACHE: ", kind)
+	log.Infoln("BOARD_CACHE_TTL: ", ttl)
+
+	switch kind {
+	case "":
+		return nil, nil
+	case "memory":
+		size, err := strconv.Atoi(getEnvValue("BOARD_CACHE_SIZE", "10000"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid BOARD_CACHE_SIZE: %w", err)
+		}
+		log.Infoln("BOARD_CACHE_SIZE: ", size)
+		return NewMemoryCache(size, ttl), nil
+	case "redis":
+		client, err := openRedis()
+		if err != nil {
+			return nil, err
+		}
+		return NewRedisCache(client, "finpc:board:", ttl), nil
+	default:
+		return nil, fmt.Errorf("invalid BOARD_CACHE: %s", kind)
+	}
+}
+
+// loadRateLimits reads RATE_LIMITS as calls per second and burst per
+// method, e.g. "/board.Board/Like=5:20,/board.Board/CreateQuestion=0.2:3".
+// Methods without a limit are not limited.
+func loadRateLimits() (RateLimiter, map[string]RateLimit, error) {
+	limits := make(map[string]RateLimit)
+
+	for _, kv := range strings.Split(getEnvValue("RATE_LIMITS", ""), ",") {
+		if kv = strings.TrimSpace(kv); kv == "" {
+			continue
+		}
+		method, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid RATE_LIMITS: %s", kv)
+		}
+		rate, burst, ok := strings.Cut(value, ":")
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid RATE_LIMITS: %s", kv)
+		}
+
+		var limit RateLimit
+		var err error
+		if limit.Rate, err = strconv.ParseFloat(rate, 64); err != nil || limit.Rate <= 0 {
+			return nil, nil, fmt.Errorf("invalid RATE_LIMITS: %s", kv)
+		}
+		if limit.Burst, err = strconv.Atoi(burst); err != nil || limit.Burst < 1 {
+			return nil, nil, fmt.Errorf("invalid RATE_LIMITS: %s", kv)
+		}
+		limits[strings.TrimSpace(method)] = limit
+	}
+
+	backend := getEnvValue("RATE_LIMIT_BACKEND", "memory")
+
+	log.Infoln("RATE_LIMITS: ", limits)
+	log.Infoln("RATE_LIMIT_BACKEND: ", backend)
+
+	switch backend {
+	case "memory":
+		size, err := strconv.Atoi(getEnvValue("RATE_LIMIT_SIZE", "100000"))
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid RATE_LIMIT_SIZE: %w", err)
+		}
+		log.Infoln("RATE_LIMIT_SIZE: ", size)
+		return NewMemoryRateLimiter(size), limits, nil
+	case "redis":
+		client, err := openRedis()
+		if err != nil {
+			return nil, nil, err
+		}
+		return NewRedisRateLimiter(client, "finpc:ratelimit:"), limits, nil
+	default:
+		return nil, nil, fmt.Errorf("invalid RATE_LIMIT_BACKEND: %s", backend)
+	}
+}
+
+func openRedis() (*redis.Client, error) {
+	options, err := redis.ParseURL(getEnvValue("REDIS_URL", "redis://localhost:6379/0"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid REDIS_URL: %w", err)
+	}
+	log.Infoln("REDIS_ADDR: ", options.Addr)
+
+	client := redis.NewClient(options)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		return nil, fmt.Errorf("failed to connect to redis: %w", err)
+	}
+	return client, nil
+}
+
+func loadPriceFeedConfig() (PriceFeedConfig, error) {
+	config := PriceFeedConfig{
+		Volatilities: make(map[string]float64),
+	}
+
+	var err error
+	if config.Seed, err = strconv.ParseInt(getEnvValue("PRICE_FEED_SEED", "1"), 10, 64); err != nil {
+		return config, fmt.Errorf("invalid PRICE_FEED_SEED: %w", err)
+	}
+	if config.Interval, err = time.ParseDuration(getEnvValue("PRICE_FEED_INTERVAL", "1s")); err != nil {
+		return config, fmt.Errorf("invalid PRICE_FEED_INTERVAL: %w", err)
+	}
+	if config.Drift, err = strconv.ParseFloat(getEnvValue("PRICE_FEED_DRIFT", "0.05"), 64); err != nil {
+		return config, fmt.Errorf("invalid PRICE_FEED_DRIFT: %w", err)
+	}
+	if config.Volatility, err = strconv.ParseFloat(getEnvValue("PRICE_FEED_VOLATILITY", "0.3"), 64); err != nil {
+		return config, fmt.Errorf("invalid PRICE_FEED_VOLATILITY: %w", err)
+	}
+	if config.Spread, err = strconv.ParseFloat(getEnvValue("PRICE_FEED_SPREAD", "0.001"), 64); err != nil {
+		return config, fmt.Errorf("invalid PRICE_FEED_SPREAD: %w", err)
+	}
+
+	// PRICE_FEED_VOLATILITIES overrides the volatility per code, e.g. "IMP=0.5,SAL=0.1"
+	for _, kv := range strings.Split(getEnvValue("PRICE_FEED_VOLATILITIES", ""), ",") {
+		if kv = strings.TrimSpace(kv); kv == "" {
+			continue
+		}
+		code, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			return config, fmt.Errorf("invalid PRICE_FEED_VOLATILITIES: %s", kv)
+		}
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return config, fmt.Errorf("invalid PRICE_FEED_VOLATILITIES: %w", err)
+		}
+		config.Volatilities[strings.ToUpper(strings.TrimSpace(code))] = v
+	}
+
+	log.Infoln("PRICE_FEED_SEED: ", config.Seed)
+	log.Infoln("PRICE_FEED_INTERVAL: ", config.Interval)
+	log.Infoln("PRICE_FEED_VOLATILITY: ", config.Volatility)
+	log.Infoln("PRICE_FEED_VOLATILITIES: ", config.Volatilities)
+
+	return config, nil
+}
+
+func initLogger() error {
+	level, err := log.ParseLevel(getEnvValue("LOG_LEVEL", "info"))
+	if err != nil {
+		return err
+	}
+	log.SetLevel(level)
+
+	switch format := getEnvValue("LOG_FORMAT", "json"); format {
+	case "json":
+		log.SetFormatter(&log.JSONFormatter{})
+	case "text":
+		log.SetFormatter(&log.TextFormatter{FullTimestamp: true})
+	default:
+		return fmt.Errorf("invalid LOG_FORMAT: %s", format)
+	}
+
+	log.Infoln("LOG_LEVEL: ", level)
+	return nil
 }
 
 func initSentry() error {
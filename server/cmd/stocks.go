@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	// project packages
+	. "github.com/ghilbut/finpc/grpc"
+)
+
+// runStocksCommand handles
+//
+//	finpc stocks import [-format csv|json] FILE
+//	finpc stocks export [-format csv|json] [FILE]
+//
+// where FILE may be "-" for stdin or stdout.
+func runStocksCommand(db *sql.DB, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: stocks import|export [-format csv|json] [FILE]")
+	}
+
+	flags := flag.NewFlagSet("stocks "+args[0], flag.ContinueOnError)
+	format := flags.String("format", "", "csv or json, guessed from the file extension if empty")
+	if err := flags.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	file := flags.Arg(0)
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(file), ".")
+	}
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "import":
+		if file == "" {
+			return fmt.Errorf("usage: stocks import [-format csv|json] FILE")
+		}
+		data, err := readInput(file)
+		if err != nil {
+			return err
+		}
+		result, err := ImportStockData(ctx, db, *format, data)
+		if err != nil {
+			return err
+		}
+		for _, e := range result.Errors {
+			fmt.Fprintf(os.Stderr, "row %d (%s): %s\n", e.Row, e.Code, e.Message)
+		}
+		fmt.Printf("imported %d stocks, %d errors\n", result.Imported, len(result.Errors))
+		if len(result.Errors) != 0 {
+			return fmt.Errorf("%d rows were not imported", len(result.Errors))
+		}
+		return nil
+	case "export":
+		if *format == "" {
+			*format = StockFormatCSV
+		}
+		data, err := ExportStockData(ctx, db, *format)
+		if err != nil {
+			return err
+		}
+		if file == "" || file == "-" {
+			_, err = os.Stdout.Write(data)
+			return err
+		}
+		return os.WriteFile(file, data, 0644)
+	default:
+		return fmt.Errorf("unknown stocks command '%s'", args[0])
+	}
+}
+
+func readInput(file string) ([]byte, error) {
+	if file == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(file)
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	// project packages
+	. "github.com/ghilbut/finpc/grpc"
+)
+
+// runTokenCommand handles
+//
+//	finpc token [-ttl DURATION] USER_ID
+//
+// and prints a bearer token for USER_ID signed with AUTH_SECRET.
+func runTokenCommand(args []string) error {
+	flags := flag.NewFlagSet("token", flag.ContinueOnError)
+	ttl := flags.Duration("ttl", 24*time.Hour, "how long the token is valid")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	userId := flags.Arg(0)
+	if userId == "" {
+		return fmt.Errorf("usage: token [-ttl DURATION] USER_ID")
+	}
+
+	secret := os.Getenv("AUTH_SECRET")
+	if secret == "" {
+		return fmt.Errorf("AUTH_SECRET is not set")
+	}
+
+	token, err := SignToken([]byte(secret), userId, *ttl)
+	if err != nil {
+		return err
+	}
+	fmt.Println(token)
+	return nil
+}
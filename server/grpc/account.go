@@ -0,0 +1,439 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"sort"
+	"strings"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	depositAccount = "system:deposits"
+	systemPrefix   = "system:"
+)
+
+// ledgerEntry amounts are integer cents, so journals balance exactly. The
+// NUMERIC(20, 2) columns are read and written as cents as well.
+type ledgerEntry struct {
+	accountId string
+	amount    int64
+}
+
+func (t *Trading) Deposit(ctx context.Context, deposit *CashDeposit) (*Balance, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/trading.Trading/Deposit")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	if !principalFromContext(ctx).Moderator {
+		loggerFromContext(ctx).Errorf("Deposit: permission denied")
+		return nil, status.Error(codes.PermissionDenied, "only a moderator can deposit cash")
+	}
+	if deposit.AccountId == "" || strings.HasPrefix(deposit.AccountId, systemPrefix) {
+		loggerFromContext(ctx).Errorf("Deposit: invalid input 'account_id'")
+		return nil, status.Error(codes.InvalidArgument, "invalid input 'account_id'")
+	}
+	amount := toCents(deposit.Amount)
+	if amount <= 0 {
+		loggerFromContext(ctx).Errorf("Deposit: invalid input 'amount'")
+		return nil, status.Error(codes.InvalidArgument, "'amount' must be positive")
+	}
+
+	if err := depositCash(db, deposit.AccountId, amount, deposit.Memo, t.initialCash); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("Deposit")
+		return nil, err
+	}
+
+	balance, err := selectBalance(ctx, db, deposit.AccountId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("Deposit")
+		return nil, err
+	}
+
+	return balance, nil
+}
+
+func (t *Trading) GetBalance(ctx context.Context, request *AccountRequest) (*Balance, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/trading.Trading/GetBalance")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	accountId, err := accountOf(ctx, request)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetBalance")
+		return nil, err
+	}
+
+	balance, err := selectBalance(ctx, db, accountId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetBalance")
+		return nil, err
+	}
+
+	return balance, nil
+}
+
+func (t *Trading) ListPositions(ctx context.Context, request *AccountRequest) (*PositionList, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/trading.Trading/ListPositions")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	accountId, err := accountOf(ctx, request)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ListPositions")
+		return nil, err
+	}
+
+	positions, err := selectPositions(ctx, db, accountId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ListPositions")
+		return nil, err
+	}
+	t.markToMarket(positions)
+
+	return &PositionList{
+		AccountId:    accountId,
+		PositionList: positions,
+	}, nil
+}
+
+func (t *Trading) GetPnL(ctx context.Context, request *AccountRequest) (*PnL, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/trading.Trading/GetPnL")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	accountId, err := accountOf(ctx, request)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetPnL")
+		return nil, err
+	}
+
+	balance, err := selectBalance(ctx, db, accountId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetPnL")
+		return nil, err
+	}
+
+	positions, err := selectPositions(ctx, db, accountId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetPnL")
+		return nil, err
+	}
+	t.markToMarket(positions)
+
+	pnl := &PnL{
+		AccountId: accountId,
+		Equity:    balance.Cash,
+	}
+	for _, p := range positions {
+		pnl.Realized += p.RealizedPnl
+		pnl.Unrealized += p.UnrealizedPnl
+		pnl.Equity += p.MarketValue
+	}
+	pnl.Realized = roundPrice(pnl.Realized)
+	pnl.Unrealized = roundPrice(pnl.Unrealized)
+	pnl.Total = roundPrice(pnl.Realized + pnl.Unrealized)
+	pnl.Equity = roundPrice(pnl.Equity)
+
+	return pnl, nil
+}
+
+func (t *Trading) markToMarket(positions []*Position) {
+	for _, p := range positions {
+		p.MarketPrice = p.AverageCost
+		if q := t.feed.Latest(p.Code); q != nil {
+			p.MarketPrice = q.Last
+		} else if trade := t.lastTrade(p.Code); trade != nil {
+			p.MarketPrice = trade.Price
+		}
+		p.MarketValue = roundPrice(p.MarketPrice * float64(p.Quantity))
+		p.UnrealizedPnl = roundPrice((p.MarketPrice - p.AverageCost) * float64(p.Quantity))
+	}
+}
+
+func accountOf(ctx context.Context, request *AccountRequest) (string, error) {
+	p := principalFromContext(ctx)
+	if request.AccountId == "" || request.AccountId == p.UserId {
+		if p.Anonymous() {
+			return "", status.Error(codes.Unauthenticated, "an account is required")
+		}
+		return p.UserId, nil
+	}
+	if !p.Moderator {
+		return "", status.Error(codes.PermissionDenied, "only a moderator can read other accounts")
+	}
+	return request.AccountId, nil
+}
+
+// lockAccounts creates missing accounts and locks them in a fixed order so
+// that concurrent fills across books can not deadlock on each other.
+func lockAccounts(tx *sql.Tx, ids []string, initialCash float64) (map[string]int64, error) {
+	unique := make([]string, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	sort.Strings(unique)
+
+	for _, id := range unique {
+		if err := ensureAccount(tx, id, initialCash); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := tx.Query("SELECT id, (cash * 100)::BIGINT FROM account WHERE id = ANY($1) ORDER BY id FOR UPDATE", pq.Array(unique))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cash := make(map[string]int64, len(unique))
+	for rows.Next() {
+		var id string
+		var amount int64
+		if err := rows.Scan(&id, &amount); err != nil {
+			return nil, err
+		}
+		cash[id] = amount
+	}
+
+	return cash, rows.Err()
+}
+
+func ensureAccount(tx *sql.Tx, id string, initialCash float64) error {
+	res, err := tx.Exec("INSERT INTO account(id) VALUES ($1) ON CONFLICT DO NOTHING", id)
+	if err != nil {
+		return err
+	}
+	amount := toCents(initialCash)
+	if n, _ := res.RowsAffected(); n == 0 || amount <= 0 {
+		return nil
+	}
+
+	return postJournal(tx, "deposit", 0, "initial cash",
+		ledgerEntry{accountId: depositAccount, amount: -amount},
+		ledgerEntry{accountId: id, amount: amount})
+}
+
+func depositCash(db *sql.DB, accountId string, amount int64, memo string, initialCash float64) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := lockAccounts(tx, []string{accountId}, initialCash); err != nil {
+		return err
+	}
+
+	err = postJournal(tx, "deposit", 0, memo,
+		ledgerEntry{accountId: depositAccount, amount: -amount},
+		ledgerEntry{accountId: accountId, amount: amount})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func postJournal(tx *sql.Tx, kind string, reference int64, memo string, entries ...ledgerEntry) error {
+	var sum int64
+	for _, e := range entries {
+		sum += e.amount
+	}
+	if sum != 0 {
+		return status.Errorf(codes.Internal, "unbalanced journal: %v", entries)
+	}
+
+	var journalId int64
+	err := tx.QueryRow(
+		"INSERT INTO journal(kind, reference, memo) VALUES ($1, $2, $3) RETURNING id",
+		kind, reference, memo).Scan(&journalId)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		_, err := tx.Exec(
+			"INSERT INTO ledger_entry(journal_id, account_id, amount) VALUES ($1, $2, $3::BIGINT / 100.0)",
+			journalId, e.accountId, e.amount)
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(e.accountId, systemPrefix) {
+			continue
+		}
+		if _, err := tx.Exec("UPDATE account SET cash = cash + $1::BIGINT / 100.0 WHERE id = $2", e.amount, e.accountId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkFunds rejects an order whose account can not cover it. cash must
+// come from lockAccounts in the same transaction.
+func checkFunds(tx *sql.Tx, order *Order, fills []fill, stockId int64, cash map[string]int64) error {
+	remaining := order.Quantity - order.FilledQuantity
+
+	if order.Side == Side_BUY {
+		required := remaining * toTicks(order.Price)
+		if order.Type == OrderType_MARKET {
+			required = 0
+			for _, f := range fills {
+				required += f.ticks * f.quantity
+			}
+		}
+
+		var reserved int64
+		err := tx.QueryRow(`
+SELECT (COALESCE(sum((quantity - filled_quantity) * price), 0) * 100)::BIGINT
+  FROM orders
+ WHERE account_id = $1 AND side = 'BUY' AND type = 'LIMIT' AND status IN ('OPEN', 'PARTIALLY_FILLED') AND id <> $2`,
+			order.AccountId, order.Id).Scan(&reserved)
+		if err != nil {
+			return err
+		}
+
+		if required > cash[order.AccountId]-reserved {
+			return status.Error(codes.FailedPrecondition, "insufficient buying power")
+		}
+		return nil
+	}
+
+	var held, reserved int64
+	err := tx.QueryRow(`
+SELECT COALESCE((SELECT quantity FROM holding WHERE account_id = $1 AND stock_id = $2), 0),
+       COALESCE((SELECT sum(quantity - filled_quantity) FROM orders
+                  WHERE account_id = $1 AND stock_id = $2 AND side = 'SELL'
+                    AND status IN ('OPEN', 'PARTIALLY_FILLED') AND id <> $3), 0)`,
+		order.AccountId, stockId, order.Id).Scan(&held, &reserved)
+	if err != nil {
+		return err
+	}
+
+	if remaining > held-reserved {
+		return status.Error(codes.FailedPrecondition, "insufficient shares held")
+	}
+	return nil
+}
+
+func settleTrade(tx *sql.Tx, trade *Trade, buyer, seller string, stockId int64) error {
+	amount := toTicks(trade.Price) * trade.Quantity
+
+	err := postJournal(tx, "trade", trade.Id, "",
+		ledgerEntry{accountId: buyer, amount: -amount},
+		ledgerEntry{accountId: seller, amount: amount})
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+INSERT INTO holding(account_id, stock_id, quantity, cost)
+VALUES ($1, $2, $3, $4::BIGINT / 100.0)
+ON CONFLICT (account_id, stock_id)
+DO UPDATE SET quantity = holding.quantity + $3, cost = holding.cost + $4::BIGINT / 100.0`,
+		buyer, stockId, trade.Quantity, amount)
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.Exec(`
+UPDATE holding
+   SET realized = realized + $3::BIGINT / 100.0 - cost * $4 / quantity,
+       cost = cost - cost * $4 / quantity,
+       quantity = quantity - $4
+ WHERE account_id = $1 AND stock_id = $2 AND quantity >= $4`,
+		seller, stockId, amount, trade.Quantity)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return status.Errorf(codes.Internal, "account '%s' does not hold the sold shares", seller)
+	}
+
+	return nil
+}
+
+func selectBalance(ctx context.Context, db *sql.DB, accountId string) (*Balance, error) {
+	var cash, reserved int64
+
+	err := db.QueryRowContext(ctx, `
+SELECT (a.cash * 100)::BIGINT,
+       (COALESCE((SELECT sum((o.quantity - o.filled_quantity) * o.price) FROM orders o
+                   WHERE o.account_id = a.id AND o.side = 'BUY' AND o.type = 'LIMIT'
+                     AND o.status IN ('OPEN', 'PARTIALLY_FILLED')), 0) * 100)::BIGINT
+  FROM account a
+ WHERE a.id = $1`, accountId).Scan(&cash, &reserved)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "account '%s' is not exists", accountId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &Balance{
+		AccountId:   accountId,
+		Cash:        fromCents(cash),
+		Reserved:    fromCents(reserved),
+		BuyingPower: fromCents(cash - reserved),
+	}, nil
+}
+
+func selectPositions(ctx context.Context, db *sql.DB, accountId string) ([]*Position, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT s.code, p.quantity, p.cost, p.realized,
+       COALESCE((SELECT sum(o.quantity - o.filled_quantity) FROM orders o
+                  WHERE o.account_id = p.account_id AND o.stock_id = p.stock_id AND o.side = 'SELL'
+                    AND o.status IN ('OPEN', 'PARTIALLY_FILLED')), 0)
+  FROM holding p
+  JOIN stocks s ON s.id = p.stock_id
+ WHERE p.account_id = $1
+ ORDER BY s.code;`, accountId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Position
+
+	for rows.Next() {
+		position := &Position{}
+		var cost float64
+		err := rows.Scan(&position.Code, &position.Quantity, &cost, &position.RealizedPnl, &position.ReservedQuantity)
+		if err != nil {
+			return nil, err
+		}
+		if position.Quantity != 0 {
+			position.AverageCost = roundPrice(cost / float64(position.Quantity))
+		}
+		list = append(list, position)
+	}
+
+	return list, rows.Err()
+}
+
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+func fromCents(cents int64) float64 {
+	return float64(cents) / 100
+}
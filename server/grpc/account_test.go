@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDepositValidation(t *testing.T) {
+	moderator := &Principal{UserId: "admin", Moderator: true}
+
+	tests := []struct {
+		name      string
+		principal *Principal
+		deposit   *CashDeposit
+		code      codes.Code
+	}{
+		{"not a moderator", &Principal{UserId: "alice"}, &CashDeposit{AccountId: "alice", Amount: 100}, codes.PermissionDenied},
+		{"no account", moderator, &CashDeposit{Amount: 100}, codes.InvalidArgument},
+		{"system account", moderator, &CashDeposit{AccountId: depositAccount, Amount: 100}, codes.InvalidArgument},
+		{"zero", moderator, &CashDeposit{AccountId: "alice"}, codes.InvalidArgument},
+		{"below a cent", moderator, &CashDeposit{AccountId: "alice", Amount: 0.004}, codes.InvalidArgument},
+		{"negative", moderator, &CashDeposit{AccountId: "alice", Amount: -100}, codes.InvalidArgument},
+	}
+
+	tr := &Trading{}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := sentry.StartTransaction(context.Background(), "test")
+			defer tx.Finish()
+
+			ctx := context.WithValue(tx.Context(), DBSession, (*sql.DB)(nil))
+			ctx = context.WithValue(ctx, principalKey, tt.principal)
+
+			if _, err := tr.Deposit(ctx, tt.deposit); status.Code(err) != tt.code {
+				t.Fatalf("err = %v, want %v", err, tt.code)
+			}
+		})
+	}
+}
+
+func TestCents(t *testing.T) {
+	tests := []struct {
+		amount float64
+		cents  int64
+	}{
+		{0, 0},
+		{0.1 + 0.2, 30},
+		{19.99, 1999},
+		{-0.005, -1},
+		{10000000, 1000000000},
+	}
+
+	for _, tt := range tests {
+		if got := toCents(tt.amount); got != tt.cents {
+			t.Errorf("toCents(%v) = %d, want %d", tt.amount, got, tt.cents)
+		}
+	}
+
+	var sum int64
+	for i := 0; i < 10; i++ {
+		sum += toCents(0.1)
+	}
+	if fromCents(sum) != 1 {
+		t.Fatalf("ten dimes = %v, want 1", fromCents(sum))
+	}
+}
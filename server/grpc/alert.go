@@ -0,0 +1,544 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	alertColumns = "a.id, a.user_id, s.code, a.type, a.threshold, a.window_seconds, a.webhook_url, a.snoozed_until, a.last_triggered_at, a.created_at"
+
+	defaultAlertWindow = 5 * time.Minute
+	maxAlertWindow     = 24 * time.Hour
+	alertDeliveryQueue = 256
+	webhookQueue       = 16
+	webhookRetries     = 3
+	webhookBackoff     = time.Second
+	webhookTimeout     = 5 * time.Second
+)
+
+type Alerts struct {
+	AlertsServer
+
+	db         *sql.DB
+	triggers   *broker[*AlertTrigger]
+	deliveries chan *AlertTrigger
+	client     *http.Client
+	backoff    time.Duration
+
+	queueMu  sync.Mutex
+	webhooks map[string]chan *AlertTrigger
+
+	mu      sync.Mutex
+	rules   map[int64]*alertRule
+	indexes map[string]*alertIndex
+}
+
+func NewAlerts(db *sql.DB) (*Alerts, error) {
+	a := &Alerts{
+		db:         db,
+		triggers:   newBroker[*AlertTrigger](),
+		deliveries: make(chan *AlertTrigger, alertDeliveryQueue),
+		client:     newWebhookClient(),
+		backoff:    webhookBackoff,
+		webhooks:   make(map[string]chan *AlertTrigger),
+		rules:      make(map[int64]*alertRule),
+		indexes:    make(map[string]*alertIndex),
+	}
+
+	alerts, err := selectAlerts(context.Background(), db, "TRUE")
+	if err != nil {
+		return nil, err
+	}
+	for _, alert := range alerts {
+		a.addRule(alert)
+	}
+
+	go a.deliver()
+
+	return a, nil
+}
+
+func (a *Alerts) CreateAlert(ctx context.Context, newAlert *NewAlert) (*Alert, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/alert.Alerts/CreateAlert")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	owner := principalFromContext(ctx)
+	if owner.Anonymous() {
+		loggerFromContext(ctx).Errorf("CreateAlert: anonymous principal")
+		return nil, status.Error(codes.Unauthenticated, "an account is required to create an alert")
+	}
+
+	window, err := validateAlert(newAlert)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CreateAlert")
+		return nil, err
+	}
+	if err := checkWebhookHost(ctx, net.DefaultResolver, newAlert.WebhookUrl); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CreateAlert")
+		return nil, err
+	}
+
+	stock, err := selectStock(ctx, db, "code", strings.ToUpper(newAlert.Code))
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CreateAlert")
+		return nil, err
+	}
+
+	alert, err := insertAlert(ctx, db, owner.UserId, stock.Id, newAlert, window)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CreateAlert")
+		return nil, err
+	}
+
+	a.addRule(alert)
+
+	return alert, nil
+}
+
+func (a *Alerts) ListAlerts(ctx context.Context, _ *emptypb.Empty) (*AlertList, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/alert.Alerts/ListAlerts")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	owner := principalFromContext(ctx)
+	if owner.Anonymous() {
+		loggerFromContext(ctx).Errorf("ListAlerts: anonymous principal")
+		return nil, status.Error(codes.Unauthenticated, "an account is required to list alerts")
+	}
+
+	list, err := selectAlerts(ctx, db, "a.user_id = $1", owner.UserId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ListAlerts")
+		return nil, err
+	}
+
+	return &AlertList{
+		AlertList: list,
+	}, nil
+}
+
+func (a *Alerts) DeleteAlert(ctx context.Context, alertId *AlertId) (*emptypb.Empty, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/alert.Alerts/DeleteAlert")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	owner := principalFromContext(ctx)
+	result, err := db.ExecContext(ctx, "DELETE FROM alert WHERE id = $1 AND user_id = $2", alertId.Id, owner.UserId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("DeleteAlert")
+		return nil, err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		loggerFromContext(ctx).Errorf("DeleteAlert: alert '%d' is not exists", alertId.Id)
+		return nil, status.Errorf(codes.NotFound, "alert '%d' is not exists", alertId.Id)
+	}
+
+	a.removeRule(alertId.Id)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (a *Alerts) SnoozeAlert(ctx context.Context, snooze *AlertSnooze) (*Alert, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/alert.Alerts/SnoozeAlert")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	owner := principalFromContext(ctx)
+	_, err := db.ExecContext(ctx,
+		"UPDATE alert SET snoozed_until = $1 WHERE id = $2 AND user_id = $3",
+		nullTime(snooze.Until), snooze.Id, owner.UserId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("SnoozeAlert")
+		return nil, err
+	}
+
+	list, err := selectAlerts(ctx, db, "a.id = $1 AND a.user_id = $2", snooze.Id, owner.UserId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("SnoozeAlert")
+		return nil, err
+	}
+	if len(list) == 0 {
+		loggerFromContext(ctx).Errorf("SnoozeAlert: alert '%d' is not exists", snooze.Id)
+		return nil, status.Errorf(codes.NotFound, "alert '%d' is not exists", snooze.Id)
+	}
+
+	a.mu.Lock()
+	if r, ok := a.rules[snooze.Id]; ok {
+		r.alert = proto.Clone(list[0]).(*Alert)
+	}
+	a.mu.Unlock()
+
+	return list[0], nil
+}
+
+func (a *Alerts) StreamAlerts(_ *emptypb.Empty, stream Alerts_StreamAlertsServer) error {
+	owner := principalFromContext(stream.Context())
+	if owner.Anonymous() {
+		loggerFromContext(stream.Context()).Errorf("StreamAlerts: anonymous principal")
+		return status.Error(codes.Unauthenticated, "an account is required to stream alerts")
+	}
+
+	triggers := a.triggers.subscribe()
+	defer a.triggers.unsubscribe(triggers)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case trigger := <-triggers:
+			if trigger.UserId != owner.UserId {
+				continue
+			}
+			if err := stream.Send(trigger); err != nil {
+				loggerFromContext(stream.Context()).WithError(err).Error("StreamAlerts")
+				return err
+			}
+		}
+	}
+}
+
+func (a *Alerts) evaluate(quotes []*Quote) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, q := range quotes {
+		index, ok := a.indexes[q.Code]
+		if !ok {
+			continue
+		}
+
+		for _, trigger := range index.evaluate(q) {
+			a.triggers.publish(trigger)
+
+			select {
+			case a.deliveries <- trigger:
+			default:
+				log.Warnf("Alerts: delivery queue is full, alert '%d' is dropped", trigger.AlertId)
+			}
+		}
+	}
+}
+
+// deliver records the trigger time outside of the quote feed and queues
+// the webhook call, so a slow webhook never holds back evaluation or the
+// webhooks of other alerts.
+func (a *Alerts) deliver() {
+	for trigger := range a.deliveries {
+		_, err := a.db.Exec("UPDATE alert SET last_triggered_at = $1 WHERE id = $2",
+			trigger.Time.AsTime(), trigger.AlertId)
+		if err != nil {
+			sentry.CaptureException(err)
+			log.Errorf("Alerts: failed to record trigger of '%d'. %s", trigger.AlertId, err)
+		}
+
+		a.mu.Lock()
+		var webhook string
+		if r, ok := a.rules[trigger.AlertId]; ok {
+			r.alert.LastTriggeredAt = trigger.Time
+			webhook = r.alert.WebhookUrl
+		}
+		a.mu.Unlock()
+
+		if webhook == "" {
+			continue
+		}
+		a.queueWebhook(webhook, trigger)
+	}
+}
+
+// queueWebhook hands the trigger to the queue of its webhook, started on
+// demand, and drops it when the webhook is too far behind.
+func (a *Alerts) queueWebhook(webhook string, trigger *AlertTrigger) {
+	a.queueMu.Lock()
+	defer a.queueMu.Unlock()
+
+	queue, ok := a.webhooks[webhook]
+	if !ok {
+		queue = make(chan *AlertTrigger, webhookQueue)
+		a.webhooks[webhook] = queue
+		go a.postWebhooks(webhook, queue)
+	}
+
+	select {
+	case queue <- trigger:
+	default:
+		log.Warnf("Alerts: webhook queue is full, trigger of '%d' is dropped", trigger.AlertId)
+	}
+}
+
+// postWebhooks calls one webhook in the order of its triggers, and stops
+// once its queue is empty.
+func (a *Alerts) postWebhooks(webhook string, queue chan *AlertTrigger) {
+	for {
+		a.queueMu.Lock()
+		var trigger *AlertTrigger
+		select {
+		case trigger = <-queue:
+		default:
+			delete(a.webhooks, webhook)
+		}
+		a.queueMu.Unlock()
+
+		if trigger == nil {
+			return
+		}
+		a.retryWebhook(webhook, trigger)
+	}
+}
+
+// retryWebhook calls the webhook until it succeeds, doubling the wait after
+// every failure, and gives up after webhookRetries retries.
+func (a *Alerts) retryWebhook(webhook string, trigger *AlertTrigger) {
+	wait := a.backoff
+	for retry := 0; ; retry++ {
+		err := a.postWebhook(webhook, trigger)
+		if err == nil {
+			return
+		}
+		if retry == webhookRetries {
+			log.Errorf("Alerts: failed to call webhook of '%d'. %s", trigger.AlertId, err)
+			return
+		}
+		time.Sleep(wait)
+		wait *= 2
+	}
+}
+
+func (a *Alerts) postWebhook(webhook string, trigger *AlertTrigger) error {
+	body, err := protojson.Marshal(trigger)
+	if err != nil {
+		return err
+	}
+
+	resp, err := a.client.Post(webhook, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
+
+// newWebhookClient checks every address it connects to, redirects included,
+// so a webhook host whose DNS answer changes after CreateAlert still can not
+// reach the server's own network.
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !publicIP(ip) {
+				return fmt.Errorf("webhook address '%s' is not public", host)
+			}
+			return nil
+		},
+	}
+
+	return &http.Client{
+		Timeout: webhookTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: webhookTimeout,
+		},
+	}
+}
+
+// checkWebhookHost rejects a webhook whose host resolves to an address that
+// is not public.
+func checkWebhookHost(ctx context.Context, resolver *net.Resolver, webhook string) error {
+	if webhook == "" {
+		return nil
+	}
+	u, err := url.Parse(webhook)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "'webhook_url' must be an http(s) url")
+	}
+
+	addrs, err := resolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "'webhook_url' host can not be resolved: %s", err)
+	}
+	for _, addr := range addrs {
+		if !publicIP(addr.IP) {
+			return status.Errorf(codes.InvalidArgument, "'webhook_url' host resolves to '%s', which is not public", addr.IP)
+		}
+	}
+
+	return nil
+}
+
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// publicIP is false for loopback, private, link-local (which covers the
+// cloud metadata endpoint), shared, unspecified and multicast addresses.
+func publicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() || sharedAddressSpace.Contains(ip))
+}
+
+func (a *Alerts) addRule(alert *Alert) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	r := &alertRule{
+		alert:  proto.Clone(alert).(*Alert),
+		window: alert.Window.AsDuration(),
+		armed:  true,
+	}
+	a.rules[alert.Id] = r
+
+	index, ok := a.indexes[alert.Code]
+	if !ok {
+		index = newAlertIndex()
+		a.indexes[alert.Code] = index
+	}
+	index.add(r)
+}
+
+func (a *Alerts) removeRule(id int64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	r, ok := a.rules[id]
+	if !ok {
+		return
+	}
+	delete(a.rules, id)
+
+	index := a.indexes[r.alert.Code]
+	index.remove(r)
+	if index.empty() {
+		delete(a.indexes, r.alert.Code)
+	}
+}
+
+func validateAlert(alert *NewAlert) (time.Duration, error) {
+	var window time.Duration
+
+	switch alert.Type {
+	case AlertType_PRICE_ABOVE, AlertType_PRICE_BELOW:
+		if alert.Threshold <= 0 {
+			return 0, status.Error(codes.InvalidArgument, "'threshold' must be a positive price")
+		}
+	case AlertType_PERCENT_MOVE, AlertType_VOLUME_SPIKE:
+		if alert.Threshold <= 0 {
+			return 0, status.Error(codes.InvalidArgument, "'threshold' must be positive")
+		}
+		window = defaultAlertWindow
+		if alert.Window != nil {
+			window = alert.Window.AsDuration()
+		}
+		if window < time.Second || window > maxAlertWindow {
+			return 0, status.Errorf(codes.InvalidArgument, "'window' must be between 1s and %s", maxAlertWindow)
+		}
+	default:
+		return 0, status.Errorf(codes.InvalidArgument, "invalid 'type' %s", alert.Type)
+	}
+
+	if alert.WebhookUrl != "" {
+		u, err := url.Parse(alert.WebhookUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return 0, status.Error(codes.InvalidArgument, "'webhook_url' must be an http(s) url")
+		}
+	}
+
+	return window, nil
+}
+
+func selectAlerts(ctx context.Context, db *sql.DB, cond string, args ...interface{}) ([]*Alert, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT `+alertColumns+`
+  FROM alert a
+  JOIN stocks s ON s.id = a.stock_id
+ WHERE `+cond+`
+ ORDER BY a.id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Alert
+
+	for rows.Next() {
+		alert, err := scanAlert(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, alert)
+	}
+
+	return list, rows.Err()
+}
+
+func insertAlert(ctx context.Context, db *sql.DB, userId string, stockId int64, newAlert *NewAlert, window time.Duration) (*Alert, error) {
+	row := db.QueryRowContext(ctx, `
+WITH a AS (
+    INSERT INTO alert(user_id, stock_id, type, threshold, window_seconds, webhook_url)
+    VALUES ($1, $2, $3, $4, $5, $6)
+    RETURNING *
+)
+SELECT `+alertColumns+`
+  FROM a
+  JOIN stocks s ON s.id = a.stock_id`,
+		userId, stockId, newAlert.Type.String(), newAlert.Threshold, int64(window.Seconds()), newAlert.WebhookUrl)
+
+	return scanAlert(row)
+}
+
+func scanAlert(row scanner) (*Alert, error) {
+	var kind string
+	var windowSeconds int64
+	var snoozedUntil, lastTriggeredAt sql.NullTime
+	var createdAt time.Time
+
+	alert := &Alert{}
+	err := row.Scan(&alert.Id, &alert.UserId, &alert.Code, &kind, &alert.Threshold, &windowSeconds,
+		&alert.WebhookUrl, &snoozedUntil, &lastTriggeredAt, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	alert.Type = AlertType(AlertType_value[kind])
+	alert.Window = durationpb.New(time.Duration(windowSeconds) * time.Second)
+	alert.SnoozedUntil = timestampOrNil(snoozedUntil)
+	alert.LastTriggeredAt = timestampOrNil(lastTriggeredAt)
+	alert.CreatedAt = timestamppb.New(createdAt)
+
+	return alert, nil
+}
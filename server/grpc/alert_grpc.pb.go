@@ -0,0 +1,278 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.23.4
+// source: alert.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AlertsClient is the client API for Alerts service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AlertsClient interface {
+	CreateAlert(ctx context.Context, in *NewAlert, opts ...grpc.CallOption) (*Alert, error)
+	ListAlerts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AlertList, error)
+	DeleteAlert(ctx context.Context, in *AlertId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SnoozeAlert(ctx context.Context, in *AlertSnooze, opts ...grpc.CallOption) (*Alert, error)
+	StreamAlerts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Alerts_StreamAlertsClient, error)
+}
+
+type alertsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAlertsClient(cc grpc.ClientConnInterface) AlertsClient {
+	return &alertsClient{cc}
+}
+
+func (c *alertsClient) CreateAlert(ctx context.Context, in *NewAlert, opts ...grpc.CallOption) (*Alert, error) {
+	out := new(Alert)
+	err := c.cc.Invoke(ctx, "/alert.Alerts/CreateAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertsClient) ListAlerts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AlertList, error) {
+	out := new(AlertList)
+	err := c.cc.Invoke(ctx, "/alert.Alerts/ListAlerts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertsClient) DeleteAlert(ctx context.Context, in *AlertId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/alert.Alerts/DeleteAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertsClient) SnoozeAlert(ctx context.Context, in *AlertSnooze, opts ...grpc.CallOption) (*Alert, error) {
+	out := new(Alert)
+	err := c.cc.Invoke(ctx, "/alert.Alerts/SnoozeAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertsClient) StreamAlerts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Alerts_StreamAlertsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Alerts_ServiceDesc.Streams[0], "/alert.Alerts/StreamAlerts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &alertsStreamAlertsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Alerts_StreamAlertsClient interface {
+	Recv() (*AlertTrigger, error)
+	grpc.ClientStream
+}
+
+type alertsStreamAlertsClient struct {
+	grpc.ClientStream
+}
+
+func (x *alertsStreamAlertsClient) Recv() (*AlertTrigger, error) {
+	m := new(AlertTrigger)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AlertsServer is the server API for Alerts service.
+// All implementations must embed UnimplementedAlertsServer
+// for forward compatibility
+type AlertsServer interface {
+	CreateAlert(context.Context, *NewAlert) (*Alert, error)
+	ListAlerts(context.Context, *emptypb.Empty) (*AlertList, error)
+	DeleteAlert(context.Context, *AlertId) (*emptypb.Empty, error)
+	SnoozeAlert(context.Context, *AlertSnooze) (*Alert, error)
+	StreamAlerts(*emptypb.Empty, Alerts_StreamAlertsServer) error
+	mustEmbedUnimplementedAlertsServer()
+}
+
+// UnimplementedAlertsServer must be embedded to have forward compatible implementations.
+type UnimplementedAlertsServer struct {
+}
+
+func (UnimplementedAlertsServer) CreateAlert(context.Context, *NewAlert) (*Alert, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlert not implemented")
+}
+func (UnimplementedAlertsServer) ListAlerts(context.Context, *emptypb.Empty) (*AlertList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlerts not implemented")
+}
+func (UnimplementedAlertsServer) DeleteAlert(context.Context, *AlertId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlert not implemented")
+}
+func (UnimplementedAlertsServer) SnoozeAlert(context.Context, *AlertSnooze) (*Alert, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnoozeAlert not implemented")
+}
+func (UnimplementedAlertsServer) StreamAlerts(*emptypb.Empty, Alerts_StreamAlertsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAlerts not implemented")
+}
+func (UnimplementedAlertsServer) mustEmbedUnimplementedAlertsServer() {}
+
+// UnsafeAlertsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AlertsServer will
+// result in compilation errors.
+type UnsafeAlertsServer interface {
+	mustEmbedUnimplementedAlertsServer()
+}
+
+func RegisterAlertsServer(s grpc.ServiceRegistrar, srv AlertsServer) {
+	s.RegisterService(&Alerts_ServiceDesc, srv)
+}
+
+func _Alerts_CreateAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewAlert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertsServer).CreateAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alert.Alerts/CreateAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertsServer).CreateAlert(ctx, req.(*NewAlert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Alerts_ListAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertsServer).ListAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alert.Alerts/ListAlerts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertsServer).ListAlerts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Alerts_DeleteAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertsServer).DeleteAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alert.Alerts/DeleteAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertsServer).DeleteAlert(ctx, req.(*AlertId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Alerts_SnoozeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertSnooze)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertsServer).SnoozeAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/alert.Alerts/SnoozeAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertsServer).SnoozeAlert(ctx, req.(*AlertSnooze))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Alerts_StreamAlerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AlertsServer).StreamAlerts(m, &alertsStreamAlertsServer{stream})
+}
+
+type Alerts_StreamAlertsServer interface {
+	Send(*AlertTrigger) error
+	grpc.ServerStream
+}
+
+type alertsStreamAlertsServer struct {
+	grpc.ServerStream
+}
+
+func (x *alertsStreamAlertsServer) Send(m *AlertTrigger) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Alerts_ServiceDesc is the grpc.ServiceDesc for Alerts service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Alerts_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "alert.Alerts",
+	HandlerType: (*AlertsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAlert",
+			Handler:    _Alerts_CreateAlert_Handler,
+		},
+		{
+			MethodName: "ListAlerts",
+			Handler:    _Alerts_ListAlerts_Handler,
+		},
+		{
+			MethodName: "DeleteAlert",
+			Handler:    _Alerts_DeleteAlert_Handler,
+		},
+		{
+			MethodName: "SnoozeAlert",
+			Handler:    _Alerts_SnoozeAlert_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamAlerts",
+			Handler:       _Alerts_StreamAlerts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "alert.proto",
+}
@@ -0,0 +1,273 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	// external packages
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPublicIP(t *testing.T) {
+	tests := []struct {
+		ip     string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.0.1", false},
+		{"fd00::1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"224.0.0.1", false},
+		{"::ffff:127.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			if got := publicIP(net.ParseIP(tt.ip)); got != tt.public {
+				t.Fatalf("publicIP = %v, want %v", got, tt.public)
+			}
+		})
+	}
+}
+
+func TestCheckWebhookHost(t *testing.T) {
+	tests := []struct {
+		webhook string
+		code    codes.Code
+	}{
+		{"", codes.OK},
+		{"https://93.184.216.34/hook", codes.OK},
+		{"http://127.0.0.1:8080/hook", codes.InvalidArgument},
+		{"http://[::1]/hook", codes.InvalidArgument},
+		{"http://169.254.169.254/latest/meta-data", codes.InvalidArgument},
+		{"http://10.0.0.5/hook", codes.InvalidArgument},
+		{"http://localhost/hook", codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.webhook, func(t *testing.T) {
+			err := checkWebhookHost(context.Background(), net.DefaultResolver, tt.webhook)
+			if status.Code(err) != tt.code {
+				t.Fatalf("err = %v, want %v", err, tt.code)
+			}
+		})
+	}
+}
+
+func TestPostWebhookRefusesLoopback(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	a := &Alerts{client: newWebhookClient()}
+	if err := a.postWebhook(server.URL, &AlertTrigger{AlertId: 1}); err == nil {
+		t.Fatal("postWebhook reached a loopback address")
+	}
+	if called {
+		t.Fatal("webhook was called")
+	}
+}
+
+// newTestWebhookAlerts delivers with a client that may reach the loopback
+// servers of the test.
+func newTestWebhookAlerts() *Alerts {
+	return &Alerts{
+		client:   &http.Client{Timeout: webhookTimeout},
+		backoff:  time.Millisecond,
+		webhooks: make(map[string]chan *AlertTrigger),
+	}
+}
+
+func TestQueueWebhookSlowWebhook(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer slow.Close()
+	defer close(release)
+
+	called := make(chan struct{}, 1)
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called <- struct{}{}
+	}))
+	defer fast.Close()
+
+	a := newTestWebhookAlerts()
+	a.queueWebhook(slow.URL, &AlertTrigger{AlertId: 1})
+	a.queueWebhook(fast.URL, &AlertTrigger{AlertId: 2})
+
+	select {
+	case <-called:
+	case <-time.After(time.Second):
+		t.Fatal("a slow webhook held back the webhook of another alert")
+	}
+}
+
+func TestQueueWebhookRetries(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		close(done)
+	}))
+	defer server.Close()
+
+	a := newTestWebhookAlerts()
+	a.queueWebhook(server.URL, &AlertTrigger{AlertId: 1})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("a failing webhook was not retried")
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		a.queueMu.Lock()
+		n := len(a.webhooks)
+		a.queueMu.Unlock()
+		if n == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the queue of a drained webhook was kept")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestAlertIndex(t *testing.T) {
+	start := time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+	rule := func(id int64, kind AlertType, threshold float64, window time.Duration) *alertRule {
+		return &alertRule{
+			alert:  &Alert{Id: id, UserId: "alice", Code: "AAA", Type: kind, Threshold: threshold},
+			window: window,
+			armed:  true,
+		}
+	}
+	type tick struct {
+		offset time.Duration
+		last   float64
+		volume int64
+	}
+
+	tests := []struct {
+		name  string
+		rules []*alertRule
+		ticks []tick
+		fired [][]int64
+	}{
+		{
+			name:  "price above fires on crossing up",
+			rules: []*alertRule{rule(1, AlertType_PRICE_ABOVE, 10, 0), rule(2, AlertType_PRICE_ABOVE, 11, 0), rule(3, AlertType_PRICE_ABOVE, 12, 0)},
+			ticks: []tick{{0, 9.5, 1}, {time.Second, 11, 1}, {2 * time.Second, 10.5, 1}, {3 * time.Second, 11, 1}},
+			fired: [][]int64{nil, {1, 2}, nil, {2}},
+		},
+		{
+			name:  "price below fires on crossing down",
+			rules: []*alertRule{rule(1, AlertType_PRICE_BELOW, 9, 0), rule(2, AlertType_PRICE_BELOW, 10, 0)},
+			ticks: []tick{{0, 10.5, 1}, {time.Second, 10, 1}, {2 * time.Second, 8, 1}},
+			fired: [][]int64{nil, {2}, {1}},
+		},
+		{
+			name:  "first tick never crosses",
+			rules: []*alertRule{rule(1, AlertType_PRICE_ABOVE, 10, 0)},
+			ticks: []tick{{0, 20, 1}},
+			fired: [][]int64{nil},
+		},
+		{
+			name:  "percent move fires once until re-armed",
+			rules: []*alertRule{rule(1, AlertType_PERCENT_MOVE, 5, time.Minute)},
+			ticks: []tick{{0, 100, 1}, {time.Second, 106, 1}, {2 * time.Second, 107, 1}, {3 * time.Second, 101, 1}, {4 * time.Second, 94, 1}},
+			fired: [][]int64{nil, {1}, nil, nil, {1}},
+		},
+		{
+			name:  "percent move uses the window",
+			rules: []*alertRule{rule(1, AlertType_PERCENT_MOVE, 5, time.Minute)},
+			ticks: []tick{{0, 100, 1}, {2 * time.Minute, 104, 1}, {3 * time.Minute, 108, 1}},
+			fired: [][]int64{nil, nil, nil},
+		},
+		{
+			name:  "volume spike",
+			rules: []*alertRule{rule(1, AlertType_VOLUME_SPIKE, 3, time.Minute)},
+			ticks: []tick{{0, 10, 100}, {time.Second, 10, 100}, {2 * time.Second, 10, 400}, {3 * time.Second, 10, 100}},
+			fired: [][]int64{nil, nil, {1}, nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x := newAlertIndex()
+			for _, r := range tt.rules {
+				x.add(r)
+			}
+
+			for i, tk := range tt.ticks {
+				triggers := x.evaluate(&Quote{Code: "AAA", Last: tk.last, Volume: tk.volume, Time: timestamppb.New(start.Add(tk.offset))})
+				var ids []int64
+				for _, trigger := range triggers {
+					ids = append(ids, trigger.AlertId)
+				}
+				if !reflect.DeepEqual(ids, tt.fired[i]) {
+					t.Fatalf("tick %d fired %v, want %v", i, ids, tt.fired[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAlertIndexSnoozeAndRemove(t *testing.T) {
+	start := time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+	quote := func(offset time.Duration, last float64) *Quote {
+		return &Quote{Code: "AAA", Last: last, Volume: 1, Time: timestamppb.New(start.Add(offset))}
+	}
+
+	snoozed := &alertRule{alert: &Alert{Id: 1, Type: AlertType_PRICE_ABOVE, Threshold: 10, SnoozedUntil: timestamppb.New(start.Add(time.Minute))}}
+	removed := &alertRule{alert: &Alert{Id: 2, Type: AlertType_PRICE_ABOVE, Threshold: 10}}
+
+	x := newAlertIndex()
+	x.add(snoozed)
+	x.add(removed)
+	x.remove(removed)
+
+	x.evaluate(quote(0, 9))
+	if triggers := x.evaluate(quote(time.Second, 11)); len(triggers) != 0 {
+		t.Fatalf("triggers = %v, want none while snoozed", triggers)
+	}
+	x.evaluate(quote(2*time.Minute, 9))
+	if triggers := x.evaluate(quote(3*time.Minute, 11)); len(triggers) != 1 || triggers[0].AlertId != 1 {
+		t.Fatalf("triggers = %v, want alert 1 after the snooze", triggers)
+	}
+
+	x.remove(snoozed)
+	if !x.empty() {
+		t.Fatal("index is not empty after removing every rule")
+	}
+}
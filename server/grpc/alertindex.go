@@ -0,0 +1,230 @@
+package grpc
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	// external packages
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type alertRule struct {
+	alert  *Alert
+	window time.Duration
+	armed  bool
+}
+
+type alertTick struct {
+	time   time.Time
+	last   float64
+	volume int64
+}
+
+// alertIndex keeps the rules of one stock sorted by threshold, so a tick
+// only touches the rules it crosses instead of every rule of the stock.
+// Price rules fire when the price crosses the threshold. Move and spike
+// rules fire once and are re-armed when the value falls back under the
+// threshold.
+type alertIndex struct {
+	above    []*alertRule
+	below    []*alertRule
+	moves    map[time.Duration][]*alertRule
+	spikes   map[time.Duration][]*alertRule
+	disarmed map[*alertRule]bool
+
+	history []alertTick
+	last    float64
+}
+
+func newAlertIndex() *alertIndex {
+	return &alertIndex{
+		moves:    make(map[time.Duration][]*alertRule),
+		spikes:   make(map[time.Duration][]*alertRule),
+		disarmed: make(map[*alertRule]bool),
+	}
+}
+
+func (x *alertIndex) add(r *alertRule) {
+	switch r.alert.Type {
+	case AlertType_PRICE_ABOVE:
+		x.above = insertRule(x.above, r)
+	case AlertType_PRICE_BELOW:
+		x.below = insertRule(x.below, r)
+	case AlertType_PERCENT_MOVE:
+		x.moves[r.window] = insertRule(x.moves[r.window], r)
+	case AlertType_VOLUME_SPIKE:
+		x.spikes[r.window] = insertRule(x.spikes[r.window], r)
+	}
+}
+
+func (x *alertIndex) remove(r *alertRule) {
+	delete(x.disarmed, r)
+
+	switch r.alert.Type {
+	case AlertType_PRICE_ABOVE:
+		x.above = removeRule(x.above, r)
+	case AlertType_PRICE_BELOW:
+		x.below = removeRule(x.below, r)
+	case AlertType_PERCENT_MOVE:
+		if x.moves[r.window] = removeRule(x.moves[r.window], r); len(x.moves[r.window]) == 0 {
+			delete(x.moves, r.window)
+		}
+	case AlertType_VOLUME_SPIKE:
+		if x.spikes[r.window] = removeRule(x.spikes[r.window], r); len(x.spikes[r.window]) == 0 {
+			delete(x.spikes, r.window)
+		}
+	}
+}
+
+func (x *alertIndex) empty() bool {
+	return len(x.above) == 0 && len(x.below) == 0 && len(x.moves) == 0 && len(x.spikes) == 0
+}
+
+func (x *alertIndex) evaluate(q *Quote) []*AlertTrigger {
+	now := q.Time.AsTime()
+	var triggers []*AlertTrigger
+
+	fire := func(r *alertRule, value float64) {
+		if r.alert.SnoozedUntil != nil && now.Before(r.alert.SnoozedUntil.AsTime()) {
+			return
+		}
+		if r.alert.Type == AlertType_PERCENT_MOVE || r.alert.Type == AlertType_VOLUME_SPIKE {
+			if !r.armed {
+				return
+			}
+			r.armed = false
+			x.disarmed[r] = true
+		}
+		triggers = append(triggers, &AlertTrigger{
+			AlertId:   r.alert.Id,
+			UserId:    r.alert.UserId,
+			Code:      r.alert.Code,
+			Type:      r.alert.Type,
+			Threshold: r.alert.Threshold,
+			Value:     value,
+			Price:     q.Last,
+			Volume:    q.Volume,
+			Time:      timestamppb.New(now),
+		})
+	}
+
+	if len(x.history) != 0 {
+		// crossed up: last < threshold <= price
+		for _, r := range between(x.above, x.last, q.Last, false) {
+			fire(r, q.Last)
+		}
+		// crossed down: price <= threshold < last
+		for _, r := range between(x.below, q.Last, x.last, true) {
+			fire(r, q.Last)
+		}
+	}
+
+	for window, rules := range x.moves {
+		base := x.since(now.Add(-window))
+		if base == nil || base.last == 0 {
+			continue
+		}
+		move := math.Abs(q.Last-base.last) / base.last * 100
+		x.evaluateLevel(AlertType_PERCENT_MOVE, window, rules, move, fire)
+	}
+
+	for window, rules := range x.spikes {
+		var sum int64
+		var n int
+		for i := len(x.history) - 1; i >= 0 && !x.history[i].time.Before(now.Add(-window)); i-- {
+			sum += x.history[i].volume
+			n++
+		}
+		if n == 0 || sum == 0 {
+			continue
+		}
+		ratio := float64(q.Volume) / (float64(sum) / float64(n))
+		x.evaluateLevel(AlertType_VOLUME_SPIKE, window, rules, ratio, fire)
+	}
+
+	x.record(alertTick{time: now, last: q.Last, volume: q.Volume})
+	return triggers
+}
+
+func (x *alertIndex) evaluateLevel(kind AlertType, window time.Duration, rules []*alertRule, value float64, fire func(*alertRule, float64)) {
+	k := sort.Search(len(rules), func(i int) bool { return rules[i].alert.Threshold > value })
+	for _, r := range rules[:k] {
+		fire(r, value)
+	}
+	for r := range x.disarmed {
+		if r.alert.Type == kind && r.window == window && r.alert.Threshold > value {
+			r.armed = true
+			delete(x.disarmed, r)
+		}
+	}
+}
+
+func (x *alertIndex) since(t time.Time) *alertTick {
+	i := sort.Search(len(x.history), func(i int) bool { return !x.history[i].time.Before(t) })
+	if i == len(x.history) {
+		return nil
+	}
+	return &x.history[i]
+}
+
+func (x *alertIndex) record(tick alertTick) {
+	x.history = append(x.history, tick)
+	x.last = tick.last
+
+	var window time.Duration
+	for w := range x.moves {
+		if w > window {
+			window = w
+		}
+	}
+	for w := range x.spikes {
+		if w > window {
+			window = w
+		}
+	}
+
+	i := sort.Search(len(x.history), func(i int) bool { return !x.history[i].time.Before(tick.time.Add(-window)) })
+	if i == len(x.history) {
+		i = len(x.history) - 1
+	}
+	x.history = append(x.history[:0], x.history[i:]...)
+}
+
+// between returns the rules with from < threshold <= to, or with
+// from <= threshold < to when lower is set. rules must be sorted.
+func between(rules []*alertRule, from, to float64, lower bool) []*alertRule {
+	if from >= to {
+		return nil
+	}
+	i := sort.Search(len(rules), func(i int) bool {
+		if lower {
+			return rules[i].alert.Threshold >= from
+		}
+		return rules[i].alert.Threshold > from
+	})
+	j := sort.Search(len(rules), func(i int) bool {
+		if lower {
+			return rules[i].alert.Threshold >= to
+		}
+		return rules[i].alert.Threshold > to
+	})
+	return rules[i:j]
+}
+
+func insertRule(rules []*alertRule, r *alertRule) []*alertRule {
+	i := sort.Search(len(rules), func(i int) bool { return rules[i].alert.Threshold > r.alert.Threshold })
+	rules = append(rules, nil)
+	copy(rules[i+1:], rules[i:])
+	rules[i] = r
+	return rules
+}
+
+func removeRule(rules []*alertRule, r *alertRule) []*alertRule {
+	for i, o := range rules {
+		if o == r {
+			return append(rules[:i], rules[i+1:]...)
+		}
+	}
+	return rules
+}
@@ -0,0 +1,206 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"github.com/lib/pq"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultAuditLogLimit = 100
+	maxAuditLogLimit     = 1000
+)
+
+// auditEntry is a row of the audit log. Before and after are the target
+// as it was and as it became, nil when it did not exist.
+type auditEntry struct {
+	actorId    string
+	method     string
+	targetType string
+	targetId   int64
+	before     proto.Message
+	after      proto.Message
+	requestId  string
+	createdAt  time.Time
+}
+
+// newAuditEntry attributes a change of target to the principal, the method
+// and the request of ctx.
+func newAuditEntry(ctx context.Context, targetType string, targetId int64, before, after proto.Message) auditEntry {
+	method, _ := grpc.Method(ctx)
+	return auditEntry{
+		actorId:    principalFromContext(ctx).UserId,
+		method:     method,
+		targetType: targetType,
+		targetId:   targetId,
+		before:     before,
+		after:      after,
+		requestId:  requestIdFromContext(ctx),
+		createdAt:  time.Now(),
+	}
+}
+
+func (b *Board) ListAuditLog(ctx context.Context, filter *AuditLogFilter) (*AuditLogList, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/ListAuditLog")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	if !principalFromContext(ctx).Moderator {
+		loggerFromContext(ctx).Errorf("ListAuditLog: permission denied")
+		return nil, status.Error(codes.PermissionDenied, "only a moderator can read the audit log")
+	}
+
+	list, err := selectAuditLog(ctx, db, filter)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ListAuditLog")
+		return nil, err
+	}
+
+	return &AuditLogList{
+		EntryList: list,
+	}, nil
+}
+
+// insertAudit writes entries in tx, so they are committed or rolled back
+// together with the change they record.
+func insertAudit(tx *sql.Tx, entries ...auditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	var (
+		actorIds    = make([]string, len(entries))
+		methods     = make([]string, len(entries))
+		targetTypes = make([]string, len(entries))
+		targetIds   = make([]int64, len(entries))
+		befores     = make([]sql.NullString, len(entries))
+		afters      = make([]sql.NullString, len(entries))
+		requestIds  = make([]string, len(entries))
+		createdAts  = make([]string, len(entries))
+	)
+
+	for i, e := range entries {
+		var err error
+		if befores[i], err = auditJSON(e.before); err != nil {
+			return err
+		}
+		if afters[i], err = auditJSON(e.after); err != nil {
+			return err
+		}
+		actorIds[i] = e.actorId
+		methods[i] = e.method
+		targetTypes[i] = e.targetType
+		targetIds[i] = e.targetId
+		requestIds[i] = e.requestId
+		createdAts[i] = e.createdAt.Format(time.RFC3339Nano)
+	}
+
+	_, err := tx.Exec(`
+INSERT INTO audit_log(actor_id, method, target_type, target_id, before, after, request_id, created_at)
+SELECT * FROM unnest($1::varchar[], $2::varchar[], $3::varchar[], $4::bigint[], $5::jsonb[], $6::jsonb[], $7::varchar[], $8::timestamptz[])`,
+		pq.Array(actorIds), pq.Array(methods), pq.Array(targetTypes), pq.Array(targetIds),
+		pq.Array(befores), pq.Array(afters), pq.Array(requestIds), pq.Array(createdAts))
+	return err
+}
+
+func auditJSON(m proto.Message) (sql.NullString, error) {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return sql.NullString{}, nil
+	}
+	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(m)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+func selectAuditLog(ctx context.Context, db *sql.DB, filter *AuditLogFilter) ([]*AuditLogEntry, error) {
+	var conds []string
+	var args []interface{}
+
+	where := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.ActorId != "" {
+		where("actor_id = $%d", filter.ActorId)
+	}
+	if filter.Method != "" {
+		where("method = $%d", filter.Method)
+	}
+	if filter.TargetType != "" {
+		where("target_type = $%d", filter.TargetType)
+	}
+	if filter.TargetId != 0 {
+		where("target_id = $%d", filter.TargetId)
+	}
+	if filter.RequestId != "" {
+		where("request_id = $%d", filter.RequestId)
+	}
+	if filter.CreatedFrom != nil {
+		where("created_at >= $%d", filter.CreatedFrom.AsTime())
+	}
+	if filter.CreatedTo != nil {
+		where("created_at < $%d", filter.CreatedTo.AsTime())
+	}
+	if filter.BeforeId != 0 {
+		where("id < $%d", filter.BeforeId)
+	}
+
+	stmt := `
+SELECT id, actor_id, method, target_type, target_id, COALESCE(before::text, ''), COALESCE(after::text, ''), request_id, created_at
+  FROM audit_log`
+	if len(conds) != 0 {
+		stmt += "\n WHERE " + strings.Join(conds, " AND ")
+	}
+
+	limit := int(filter.Limit)
+	if limit <= 0 {
+		limit = defaultAuditLogLimit
+	}
+	if limit > maxAuditLogLimit {
+		limit = maxAuditLogLimit
+	}
+	args = append(args, limit)
+	stmt += fmt.Sprintf("\n ORDER BY id DESC\n LIMIT $%d;", len(args))
+
+	rows, err := db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*AuditLogEntry
+
+	for rows.Next() {
+		entry := &AuditLogEntry{}
+		var createdAt time.Time
+
+		err := rows.Scan(
+			&entry.Id, &entry.ActorId, &entry.Method, &entry.TargetType, &entry.TargetId,
+			&entry.Before, &entry.After, &entry.RequestId, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+		entry.CreatedAt = timestamppb.New(createdAt)
+
+		list = append(list, entry)
+	}
+
+	return list, rows.Err()
+}
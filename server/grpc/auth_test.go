@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	// external packages
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestVerifyToken(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Now()
+
+	valid, err := SignToken(secret, "alice", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, signature, _ := strings.Cut(valid, ".")
+	forged, _ := SignToken([]byte("other"), "alice", time.Hour)
+	expired, _ := SignToken(secret, "alice", -time.Second)
+
+	tests := []struct {
+		name   string
+		token  string
+		userId string
+		err    error
+	}{
+		{"valid", valid, "alice", nil},
+		{"wrong secret", forged, "", errInvalidToken},
+		{"expired", expired, "", errExpiredToken},
+		{"no signature", payload, "", errMalformedToken},
+		{"bad encoding", payload + ".!!", "", errMalformedToken},
+		{"tampered payload", "e30." + signature, "", errInvalidToken},
+		{"empty", "", "", errMalformedToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userId, err := VerifyToken(secret, tt.token, now)
+			if err != tt.err {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if userId != tt.userId {
+				t.Fatalf("userId = %q, want %q", userId, tt.userId)
+			}
+		})
+	}
+}
+
+func TestNewPrincipal(t *testing.T) {
+	secret := []byte("secret")
+	moderators := []string{"mod"}
+
+	alice, _ := SignToken(secret, "alice", time.Hour)
+	mod, _ := SignToken(secret, "mod", time.Hour)
+
+	tests := []struct {
+		name      string
+		md        metadata.MD
+		userId    string
+		moderator bool
+		code      codes.Code
+	}{
+		{"anonymous", metadata.MD{}, "", false, codes.OK},
+		{"spoofed userid header", metadata.Pairs("userid", "mod"), "", false, codes.OK},
+		{"user", metadata.Pairs(authorizationKey, "Bearer "+alice), "alice", false, codes.OK},
+		{"moderator", metadata.Pairs(authorizationKey, "Bearer "+mod), "mod", true, codes.OK},
+		{"not bearer", metadata.Pairs(authorizationKey, "Basic "+alice), "", false, codes.Unauthenticated},
+		{"bad token", metadata.Pairs(authorizationKey, "Bearer x.y"), "", false, codes.Unauthenticated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			p, err := newPrincipal(ctx, secret, moderators)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("code = %s, want %s", code, tt.code)
+			}
+			if err != nil {
+				return
+			}
+			if p.UserId != tt.userId || p.Moderator != tt.moderator {
+				t.Fatalf("principal = %+v, want %q moderator %v", p, tt.userId, tt.moderator)
+			}
+		})
+	}
+}
+
+func TestNewPrincipalWithoutSecret(t *testing.T) {
+	token, _ := SignToken(nil, "alice", time.Hour)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationKey, "Bearer "+token))
+
+	if _, err := newPrincipal(ctx, nil, nil); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("err = %v, want Unauthenticated", err)
+	}
+}
@@ -3,16 +3,27 @@ package grpc
 import (
 	"context"
 	"database/sql"
-	"errors"
-	"fmt"
+	"time"
+
 	// external packages
 	"github.com/getsentry/sentry-go"
-	log "github.com/sirupsen/logrus"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Board struct {
 	BoardServer
+
+	editWindow time.Duration
+	events     *broker[*SubjectEvent]
+	likes      *LikeBuffer
+	cache      *boardCache
+	stmts      *boardStatements
+	replica    *replicaRouter
+	searcher   QuestionSearcher
 }
 
 func (b *Board) ListSubjects(ctx context.Context, empty *emptypb.Empty) (*SubjectList, error) {
@@ -20,37 +31,35 @@ func (b *Board) ListSubjects(ctx context.Context, empty *emptypb.Empty) (*Subjec
 	span := tx.StartChild("/board.Board/ListSubjects")
 	defer span.Finish()
 
-	db := ctx.Value(DBSession).(*sql.DB)
-
-	rows, err := db.QueryContext(ctx, "SELECT id, title, enabled FROM subject ORDER BY id;")
-	if err != nil {
-		log.Errorf("ListSubjects: %s", err)
-		return nil, err
-	}
-	defer rows.Close()
-
-	var list []*Subject
-
-	for rows.Next() {
-		var id int64
-		var title string
-		var enabled bool
+	client := b.readClient(ctx, subjectsCacheKey)
 
-		if err := rows.Scan(&id, &title, &enabled); err != nil {
-			log.Errorf("ListSubjects: %s", err)
+	load := func(ctx context.Context) (*SubjectList, error) {
+		var list []*Subject
+		err := b.read(client, func(stmts *boardStatements) (err error) {
+			list, err = selectSubjects(ctx, stmts)
+			return err
+		})
+		if err != nil {
 			return nil, err
 		}
+		return &SubjectList{
+			SubjectList: list,
+		}, nil
+	}
 
-		list = append(list, &Subject{
-			Id:      id,
-			Title:   title,
-			Enabled: enabled,
-		})
+	var list *SubjectList
+	var err error
+	if b.cache != nil {
+		list, err = b.cache.subjects(ctx, load)
+	} else {
+		list, err = load(ctx)
+	}
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ListSubjects")
+		return nil, err
 	}
 
-	return &SubjectList{
-		SubjectList: list,
-	}, nil
+	return list, nil
 }
 
 func (b *Board) GetSubject(ctx context.Context, subjectId *SubjectId) (*Subject, error) {
@@ -58,119 +67,288 @@ func (b *Board) GetSubject(ctx context.Context, subjectId *SubjectId) (*Subject,
 	span := tx.StartChild("/board.Board/GetSubject")
 	defer span.Finish()
 
-	db := ctx.Value(DBSession).(*sql.DB)
+	var subject *Subject
 
-	rows, err := db.Query("SELECT id, title, enabled FROM subject WHERE id = $1", subjectId.Id)
-	if err != nil {
-		log.Errorf("GetSubject: %s", err)
-		return nil, err
-	}
-	defer rows.Close()
+	err := b.read(clientIdentity(ctx), func(stmts *boardStatements) error {
+		rows, err := stmts.selectSubject.QueryContext(ctx, subjectId.Id)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
 
-	subject := &Subject{}
+		subject = nil
 
-	for rows.Next() {
-		if err := rows.Scan(&subject.Id, &subject.Title, &subject.Enabled); err != nil {
-			log.Errorf("GetSubject: %s", err)
-			return nil, err
+		for rows.Next() {
+			if subject, err = scanSubject(rows); err != nil {
+				return err
+			}
 		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		if subject == nil {
+			return status.Errorf(codes.NotFound, "subject '%d' is not exists", subjectId.Id)
+		}
+
+		return attachTags(ctx, stmts.db, subject)
+	})
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetSubject")
+		return nil, err
 	}
 
 	return subject, nil
 }
 
-func (b *Board) CreateQuestion(ctx context.Context, newQuestion *NewQuestion) (*emptypb.Empty, error) {
+func (b *Board) CreateQuestion(ctx context.Context, newQuestion *NewQuestion) (*Question, error) {
 	tx := sentry.TransactionFromContext(ctx)
 	span := tx.StartChild("/board.Board/CreateQuestion")
 	defer span.Finish()
 
-	db := ctx.Value(DBSession).(*sql.DB)
-
-	subject, err := selectSubject(db, newQuestion.SubjectId)
+	subject, err := selectSubject(b.stmts, newQuestion.SubjectId)
 	if err != nil {
-		log.Errorf("CreateQuestion: failed to select subject. %s", err)
+		loggerFromContext(ctx).WithError(err).Error("CreateQuestion: failed to select subject")
 		return nil, err
 	}
 
 	if subject == nil {
-		log.Errorf("CreateQuestion: subjectId '%d' is not exists", newQuestion.SubjectId)
-		return nil, errors.New("this subject is not exists")
+		loggerFromContext(ctx).Errorf("CreateQuestion: subjectId '%d' is not exists", newQuestion.SubjectId)
+		return nil, status.Error(codes.NotFound, "this subject is not exists")
 	}
 
-	if subject.Enabled == false {
-		log.Errorf("CreateQuestion: subjectId '%d' is disable", subject.Id)
+	if err := checkSubjectOpen(subject, time.Now()); err != nil {
+		loggerFromContext(ctx).Errorf("CreateQuestion: subjectId '%d' is not open", subject.Id)
 		return nil, err
 	}
 
 	if len(newQuestion.GetQuestion()) == 0 {
-		log.Errorf("CreateQuestion: empty input 'question'")
+		loggerFromContext(ctx).Errorf("CreateQuestion: empty input 'question'")
+		return nil, status.Error(codes.InvalidArgument, "empty input 'question'")
+	}
+
+	author := principalFromContext(ctx)
+
+	question, err := insertQuestion(ctx, b.stmts, newQuestion.Question, newQuestion.SubjectId, author.UserId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CreateQuestion")
+
 		return nil, err
 	}
 
-	err = insertQuestion(db, newQuestion.Question, newQuestion.SubjectId)
+	b.questionsChanged(ctx, newQuestion.SubjectId)
+
+	return question, nil
+}
+
+func (b *Board) UpdateQuestion(ctx context.Context, editedQuestion *EditedQuestion) (*Question, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/UpdateQuestion")
+	defer span.Finish()
+
+	if len(editedQuestion.GetQuestion()) == 0 {
+		loggerFromContext(ctx).Errorf("UpdateQuestion: empty input 'question'")
+		return nil, status.Error(codes.InvalidArgument, "empty input 'question'")
+	}
+
+	subject, err := checkQuestionNotClosed(b.stmts, editedQuestion.Id)
 	if err != nil {
-		log.Errorf("CreateQuestion: %s", err)
+		loggerFromContext(ctx).WithError(err).Error("UpdateQuestion")
+		return nil, err
+	}
 
+	editor := principalFromContext(ctx)
+
+	question, err := updateQuestion(ctx, b.stmts, editedQuestion.Id, editedQuestion.Question, editor.UserId, b.canModify(editor))
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("UpdateQuestion")
 		return nil, err
 	}
 
-	return nil, nil
+	b.questionsChanged(ctx, subject.Id)
+
+	return question, nil
 }
 
-func (b *Board) ListQuestions(ctx context.Context, subjectId *SubjectId) (*QuestionList, error) {
+func (b *Board) DeleteQuestion(ctx context.Context, questionId *QuestionId) (*emptypb.Empty, error) {
 	tx := sentry.TransactionFromContext(ctx)
-	span := tx.StartChild("/board.Board/ListQuestions")
+	span := tx.StartChild("/board.Board/DeleteQuestion")
 	defer span.Finish()
 
-	db := ctx.Value(DBSession).(*sql.DB)
+	subject, err := checkQuestionNotClosed(b.stmts, questionId.Id)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("DeleteQuestion")
+		return nil, err
+	}
 
-	rows, err := db.Query(
-		"SELECT id, question, likes FROM question WHERE subject_id = $1 ORDER BY likes DESC, question ASC;",
-		subjectId.Id)
-	defer rows.Close()
+	if err := b.flushLikes(); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("DeleteQuestion")
+		return nil, err
+	}
+
+	editor := principalFromContext(ctx)
+
+	if err := deleteQuestion(ctx, b.stmts, questionId.Id, editor.UserId, b.canModify(editor)); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("DeleteQuestion")
+		return nil, err
+	}
+
+	// the subject list counts the questions of each tag
+	b.questionsChanged(ctx, subject.Id)
+	b.subjectsChanged(ctx)
+
+	return &emptypb.Empty{}, nil
+}
 
+func (b *Board) RestoreQuestion(ctx context.Context, questionId *QuestionId) (*Question, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/RestoreQuestion")
+	defer span.Finish()
+
+	editor := principalFromContext(ctx)
+
+	question, subjectId, err := restoreQuestion(ctx, b.stmts, questionId.Id, editor)
 	if err != nil {
-		log.Errorf("ListQuestions: %s", err)
+		loggerFromContext(ctx).WithError(err).Error("RestoreQuestion")
 		return nil, err
 	}
 
-	var list []*Question
+	b.questionsChanged(ctx, subjectId)
+	b.subjectsChanged(ctx)
+
+	return question, nil
+}
+
+func (b *Board) ListQuestionEdits(ctx context.Context, questionId *QuestionId) (*QuestionEditList, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/ListQuestionEdits")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	rows, err := db.QueryContext(ctx,
+		`
+SELECT e.id, e.question_id, e.action, e.question, e.editor_id, e.edited_at
+  FROM question_edit e
+  JOIN question q ON q.id = e.question_id AND q.deleted_at IS NULL
+  JOIN subject s ON s.id = q.subject_id AND s.deleted_at IS NULL
+ WHERE e.question_id = $1
+ ORDER BY e.id;`,
+		questionId.Id)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ListQuestionEdits")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*QuestionEdit
 
 	for rows.Next() {
-		var id int64
-		var question string
-		var likesCount int64
+		edit := &QuestionEdit{}
+		var editedAt time.Time
 
-		if err := rows.Scan(&id, &question, &likesCount); err != nil {
-			log.Errorf("ListQuestions: %s", err)
+		if err := rows.Scan(&edit.Id, &edit.QuestionId, &edit.Action, &edit.Question, &edit.EditorId, &editedAt); err != nil {
+			loggerFromContext(ctx).WithError(err).Error("ListQuestionEdits")
 			return nil, err
 		}
+		edit.EditedAt = timestamppb.New(editedAt)
 
-		list = append(list, &Question{
-			Id:         id,
-			Question:   question,
-			LikesCount: likesCount,
-		})
+		list = append(list, edit)
 	}
 
-	return &QuestionList{
-		QuestionList: list,
+	return &QuestionEditList{
+		EditList: list,
 	}, nil
 }
 
+func (b *Board) canModify(p *Principal) func(*Question) error {
+	return func(q *Question) error {
+		if p.Moderator {
+			return nil
+		}
+		if p.Anonymous() || p.UserId != q.AuthorId {
+			return status.Error(codes.PermissionDenied, "only the author or a moderator can change this question")
+		}
+		if b.editWindow > 0 && time.Since(q.CreatedAt.AsTime()) > b.editWindow {
+			return status.Errorf(codes.FailedPrecondition, "the %s edit window has passed", b.editWindow)
+		}
+		return nil
+	}
+}
+
+func (b *Board) ListQuestions(ctx context.Context, filter *QuestionFilter) (*QuestionList, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/ListQuestions")
+	defer span.Finish()
+
+	client := b.readClient(ctx, questionsCacheKey(filter.SubjectId))
+
+	load := func(ctx context.Context) (*QuestionList, error) {
+		var list []*Question
+		err := b.read(client, func(stmts *boardStatements) (err error) {
+			list, err = selectQuestions(ctx, stmts, filter)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &QuestionList{
+			QuestionList: list,
+		}, nil
+	}
+
+	var list *QuestionList
+	var err error
+	if b.cache != nil {
+		list, err = b.cache.questions(ctx, filter, load)
+	} else {
+		list, err = load(ctx)
+	}
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ListQuestions")
+		return nil, err
+	}
+	if b.likes != nil {
+		b.likes.merge(list.QuestionList)
+	}
+
+	return list, nil
+}
+
 func (b *Board) Like(ctx context.Context, questionId *QuestionId) (*emptypb.Empty, error) {
 	tx := sentry.TransactionFromContext(ctx)
 	span := tx.StartChild("/board.Board/Like")
 	defer span.Finish()
 
-	db := ctx.Value(DBSession).(*sql.DB)
+	if b.likes != nil {
+		if err := checkQuestionOpen(b.stmts, questionId.Id); err != nil {
+			loggerFromContext(ctx).WithError(err).Error("Like")
+			return nil, err
+		}
+		if err := b.likes.add(questionId.Id, 1, clientIdentity(ctx), newAuditEntry(ctx, "question", questionId.Id, nil, nil)); err != nil {
+			loggerFromContext(ctx).WithError(err).Error("Like")
+			return nil, err
+		}
+		b.wrote(ctx)
+		return &emptypb.Empty{}, nil
+	}
 
-	if err := addQuestionLikes(db, questionId.Id); err != nil {
-		log.Errorf("Like: %s", err)
+	subjectId, ok, err := updateQuestionLikes(ctx, b.stmts, questionId.Id, 1)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("Like")
+		return nil, err
+	}
+	if !ok {
+		err := checkQuestionOpen(b.stmts, questionId.Id)
+		if err == nil {
+			err = status.Error(codes.Aborted, "like was not applied, try again")
+		}
+		loggerFromContext(ctx).WithError(err).Error("Like")
 		return nil, err
 	}
 
-	return nil, nil
+	b.questionsChanged(ctx, subjectId)
+
+	return &emptypb.Empty{}, nil
 }
 
 func (b *Board) Unlike(ctx context.Context, questionId *QuestionId) (*emptypb.Empty, error) {
@@ -178,125 +356,430 @@ func (b *Board) Unlike(ctx context.Context, questionId *QuestionId) (*emptypb.Em
 	span := tx.StartChild("/board.Board/Unlike")
 	defer span.Finish()
 
-	db := ctx.Value(DBSession).(*sql.DB)
+	if b.likes != nil {
+		return b.unlikeBuffered(ctx, questionId.Id)
+	}
 
-	question, err := selectQuestion(db, questionId.Id)
+	subjectId, ok, err := updateQuestionLikes(ctx, b.stmts, questionId.Id, -1)
 	if err != nil {
-		log.Errorf("Unlike: %s", err)
+		loggerFromContext(ctx).WithError(err).Error("Unlike")
 		return nil, err
 	}
-	if question.LikesCount <= 0 {
-		err = fmt.Errorf("like count can not be negative")
-		log.Errorf("Unlike: %s", err)
+	if !ok {
+		err := checkQuestionOpen(b.stmts, questionId.Id)
+		if err == nil {
+			err = status.Error(codes.FailedPrecondition, "like count can not be negative")
+		}
+		loggerFromContext(ctx).WithError(err).Error("Unlike")
 		return nil, err
 	}
 
-	if err := subQuestionLikes(db, questionId.Id); err != nil {
-		log.Errorf("Unlike: %s", err)
+	b.questionsChanged(ctx, subjectId)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (b *Board) unlikeBuffered(ctx context.Context, questionId int64) (*emptypb.Empty, error) {
+	if err := checkQuestionOpen(b.stmts, questionId); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("Unlike")
+		return nil, err
+	}
+
+	likes, err := selectQuestionLikes(b.stmts, questionId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("Unlike")
+		return nil, err
+	}
+	ok, err := b.likes.sub(questionId, likes, clientIdentity(ctx), newAuditEntry(ctx, "question", questionId, nil, nil))
+	if err == nil && !ok {
+		err = status.Error(codes.FailedPrecondition, "like count can not be negative")
+	}
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("Unlike")
 		return nil, err
 	}
+	b.wrote(ctx)
 
-	return nil, nil
+	return &emptypb.Empty{}, nil
 }
 
-func selectSubject(db *sql.DB, id int64) (*Subject, error) {
-	rows, err := db.Query("SELECT id, title, enabled FROM subject WHERE id = $1", id)
+// likesFlushed pins the clients of the flushed likes to the primary again,
+// since their likes just left LikeBuffer.merge while the replica may not
+// have replayed the flush yet.
+func (b *Board) likesFlushed(subjectIds []int64, clients []string) {
+	if b.replica != nil {
+		for _, client := range clients {
+			b.replica.wrote(client)
+		}
+	}
+	b.questionsChanged(context.Background(), subjectIds...)
+}
+
+// flushLikes writes the buffered likes before a question or subject stops
+// taking them, so the ranking snapshot of a closed subject counts them.
+// Likes buffered after it are dropped by the flush that finds the question
+// closed or deleted.
+func (b *Board) flushLikes() error {
+	if b.likes == nil {
+		return nil
+	}
+	return b.likes.Flush()
+}
+
+// questionsChanged records a write to the questions of the subjects. It
+// drops their cached lists and keeps the writer on the primary for a
+// while. Buffered likes need no call here since ListQuestions merges them
+// into every response; the buffer calls it once they are flushed.
+func (b *Board) questionsChanged(ctx context.Context, subjectIds ...int64) {
+	keys := make([]string, len(subjectIds))
+	for i, id := range subjectIds {
+		keys[i] = questionsCacheKey(id)
+	}
+	b.wrote(ctx, keys...)
+
+	if b.cache == nil || len(keys) == 0 {
+		return
+	}
+	b.cache.invalidate(ctx, keys...)
+}
+
+func (b *Board) subjectsChanged(ctx context.Context) {
+	b.wrote(ctx, subjectsCacheKey)
+
+	if b.cache == nil {
+		return
+	}
+	b.cache.invalidate(ctx, subjectsCacheKey)
+}
+
+// readClient is the client a list is read for. A cached list is shared by
+// every client, so it is read by the fill of its key, which the writes
+// invalidating the key pin to the primary.
+func (b *Board) readClient(ctx context.Context, key string) string {
+	if b.cache != nil {
+		return cacheFillClient(key)
+	}
+	return clientIdentity(ctx)
+}
+
+// wrote pins the caller, and the fills of the cache keys the write
+// invalidates, to the primary.
+func (b *Board) wrote(ctx context.Context, keys ...string) {
+	if b.replica == nil {
+		return
+	}
+
+	clients := []string{clientIdentity(ctx)}
+	for _, key := range keys {
+		clients = append(clients, cacheFillClient(key))
+	}
+	b.replica.wrote(clients...)
+}
+
+func cacheFillClient(key string) string {
+	return "cache:" + key
+}
+
+func selectSubjects(ctx context.Context, stmts *boardStatements) ([]*Subject, error) {
+	rows, err := stmts.selectSubjects.QueryContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	subject := &Subject{}
+	var list []*Subject
 
 	for rows.Next() {
-		if err := rows.Scan(&subject.Id, &subject.Title, &subject.Enabled); err != nil {
+		subject, err := scanSubject(rows)
+		if err != nil {
 			return nil, err
 		}
+
+		list = append(list, subject)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	return subject, nil
+	if err := attachTags(ctx, stmts.db, list...); err != nil {
+		return nil, err
+	}
+
+	return list, nil
 }
 
-func insertQuestion(db *sql.DB, question string, subjectId int64) error {
-	stmt, err := db.Prepare("INSERT INTO question(question, subject_id) VALUES ($1, $2)")
+func selectSubject(stmts *boardStatements, id int64) (*Subject, error) {
+	rows, err := stmts.selectSubject.Query(id)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer stmt.Close()
+	defer rows.Close()
 
-	tx, err := db.Begin()
-	if err != nil {
-		return err
-	}
+	var subject *Subject
 
-	_, err = tx.Stmt(stmt).Exec(question, subjectId)
-	if err != nil {
-		tx.Rollback()
-		return err
+	for rows.Next() {
+		if subject, err = scanSubject(rows); err != nil {
+			return nil, err
+		}
 	}
 
-	tx.Commit()
-	return nil
+	return subject, nil
 }
 
-func selectQuestion(db *sql.DB, id int64) (*Question, error) {
-	rows, err := db.Query("SELECT id, question, likes FROM question WHERE id = $1", id)
+func selectQuestions(ctx context.Context, stmts *boardStatements, filter *QuestionFilter) ([]*Question, error) {
+	stmt := stmts.selectQuestions
+	args := []interface{}{filter.SubjectId}
+
+	if tags := uniqueTags(filter.Tags); len(tags) != 0 {
+		args = append(args, pq.Array(tags))
+		if filter.MatchAll {
+			stmt = stmts.selectQuestionsAllTags
+		} else {
+			stmt = stmts.selectQuestionsAnyTag
+		}
+	}
+
+	rows, err := stmt.QueryContext(ctx, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	question := &Question{}
+	var list []*Question
 
 	for rows.Next() {
-		if err := rows.Scan(&question.Id, &question.Question, &question.LikesCount); err != nil {
+		var tags []string
+
+		question, err := scanQuestion(rows, pq.Array(&tags))
+		if err != nil {
 			return nil, err
 		}
+		question.Tags = tags
+
+		list = append(list, question)
+	}
+
+	return list, rows.Err()
+}
+
+func insertQuestion(ctx context.Context, stmts *boardStatements, text string, subjectId int64, authorId string) (*Question, error) {
+	tx, err := stmts.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	question, err := scanQuestion(tx.Stmt(stmts.insertQuestion).QueryRow(text, subjectId, authorId))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := insertQuestionEdit(tx, stmts, question.Id, "create", text, authorId); err != nil {
+		return nil, err
+	}
+
+	if err := insertAudit(tx, newAuditEntry(ctx, "question", question.Id, nil, question)); err != nil {
+		return nil, err
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	return question, nil
 }
 
-func addQuestionLikes(db *sql.DB, questionId int64) error {
-	stmt, err := db.Prepare("UPDATE question SET likes = likes + 1 WHERE id = $1")
+func updateQuestion(ctx context.Context, stmts *boardStatements, id int64, text, editorId string, authorize func(*Question) error) (*Question, error) {
+	tx, err := stmts.db.Begin()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer stmt.Close()
+	defer tx.Rollback()
 
-	tx, err := db.Begin()
+	before, err := selectQuestionForUpdate(tx, stmts, id)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if err := authorize(before); err != nil {
+		return nil, err
 	}
 
-	_, err = tx.Stmt(stmt).Exec(questionId)
+	question, err := scanQuestion(tx.QueryRow(
+		"UPDATE question SET question = $1, updated_at = now() WHERE id = $2 RETURNING "+questionColumns,
+		text, id))
 	if err != nil {
-		tx.Rollback()
-		return err
+		return nil, err
+	}
+
+	if err := insertQuestionEdit(tx, stmts, id, "update", text, editorId); err != nil {
+		return nil, err
 	}
 
-	tx.Commit()
-	return nil
+	if err := insertAudit(tx, newAuditEntry(ctx, "question", id, before, question)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return question, nil
 }
 
-func subQuestionLikes(db *sql.DB, questionId int64) error {
-	stmt, err := db.Prepare("UPDATE question SET likes = likes - 1 WHERE id = $1")
+func deleteQuestion(ctx context.Context, stmts *boardStatements, id int64, editorId string, authorize func(*Question) error) error {
+	tx, err := stmts.db.Begin()
 	if err != nil {
 		return err
 	}
-	defer stmt.Close()
+	defer tx.Rollback()
 
-	tx, err := db.Begin()
+	question, err := selectQuestionForUpdate(tx, stmts, id)
 	if err != nil {
 		return err
 	}
+	if err := authorize(question); err != nil {
+		return err
+	}
 
-	_, err = tx.Stmt(stmt).Exec(questionId)
-	if err != nil {
-		tx.Rollback()
+	if _, err := tx.Exec("UPDATE question SET deleted_at = now(), updated_at = now() WHERE id = $1", id); err != nil {
 		return err
 	}
 
-	tx.Commit()
-	return nil
+	if err := insertQuestionEdit(tx, stmts, id, "delete", question.Question, editorId); err != nil {
+		return err
+	}
+
+	if err := insertAudit(tx, newAuditEntry(ctx, "question", id, question, nil)); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
+
+// restoreQuestion brings back a deleted question for its author or a
+// moderator, as long as its subject is neither deleted nor closed. It
+// returns the question and its subject.
+func restoreQuestion(ctx context.Context, stmts *boardStatements, id int64, editor *Principal) (*Question, int64, error) {
+	tx, err := stmts.db.Begin()
+	if err != nil {
+		return nil, 0, err
+	}
+	defer tx.Rollback()
+
+	var authorId string
+	var subjectId int64
+	var subjectDeleted, subjectClosed bool
+
+	err = tx.QueryRow(`
+SELECT q.author_id, q.subject_id, s.deleted_at IS NOT NULL, s.closed_at IS NOT NULL
+  FROM question q
+  JOIN subject s ON s.id = q.subject_id
+ WHERE q.id = $1 AND q.deleted_at IS NOT NULL
+   FOR UPDATE OF q`, id).Scan(&authorId, &subjectId, &subjectDeleted, &subjectClosed)
+	if err == sql.ErrNoRows {
+		return nil, 0, status.Errorf(codes.NotFound, "deleted question '%d' is not exists", id)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !editor.Moderator && (editor.Anonymous() || editor.UserId != authorId) {
+		return nil, 0, status.Error(codes.PermissionDenied, "only the author or a moderator can restore this question")
+	}
+	if subjectDeleted {
+		return nil, 0, status.Error(codes.FailedPrecondition, "the subject of this question is deleted")
+	}
+	if subjectClosed {
+		return nil, 0, status.Error(codes.FailedPrecondition, "this subject is closed and read-only")
+	}
+
+	question, err := scanQuestion(tx.QueryRow(
+		"UPDATE question SET deleted_at = NULL, updated_at = now() WHERE id = $1 RETURNING "+questionColumns,
+		id))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := insertQuestionEdit(tx, stmts, id, "restore", question.Question, editor.UserId); err != nil {
+		return nil, 0, err
+	}
+
+	if err := insertAudit(tx, newAuditEntry(ctx, "question", id, nil, question)); err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, 0, err
+	}
+	return question, subjectId, nil
+}
+
+func selectQuestionForUpdate(tx *sql.Tx, stmts *boardStatements, id int64) (*Question, error) {
+	question, err := scanQuestion(tx.Stmt(stmts.selectQuestionForUpdate).QueryRow(id))
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "question '%d' is not exists", id)
+	}
+	return question, err
+}
+
+// scanQuestion scans the questionColumns of row, followed by dest.
+func scanQuestion(row scanner, dest ...interface{}) (*Question, error) {
+	question := &Question{}
+	var createdAt, updatedAt time.Time
+
+	dest = append([]interface{}{
+		&question.Id, &question.Question, &question.LikesCount, &question.AuthorId, &createdAt, &updatedAt, &question.Answered,
+	}, dest...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	question.CreatedAt = timestamppb.New(createdAt)
+	question.UpdatedAt = timestamppb.New(updatedAt)
+
+	return question, nil
+}
+
+func insertQuestionEdit(tx *sql.Tx, stmts *boardStatements, questionId int64, action, text, editorId string) error {
+	_, err := tx.Stmt(stmts.insertQuestionEdit).Exec(questionId, action, text, editorId)
+	return err
+}
+
+// updateQuestionLikes changes likes by delta in one conditional UPDATE, so
+// concurrent calls can neither lose an update nor go below zero. It returns
+// the subject of the question, or false when the question is missing, its
+// subject is not open, or the count is already zero.
+func updateQuestionLikes(ctx context.Context, stmts *boardStatements, questionId int64, delta int64) (int64, bool, error) {
+	var subjectId int64
+	var ok bool
+
+	err := inTx(stmts.db, func(tx *sql.Tx) error {
+		var likes int64
+		err := tx.Stmt(stmts.updateQuestionLikes).QueryRow(questionId, delta).Scan(&subjectId, &likes)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		ok = true
+
+		return insertAudit(tx, newAuditEntry(ctx, "question", questionId,
+			&Question{Id: questionId, LikesCount: likes - delta},
+			&Question{Id: questionId, LikesCount: likes}))
+	})
+
+	return subjectId, ok, err
+}
+
+const updateQuestionLikesQuery = `
+UPDATE question q
+   SET likes = q.likes + $2, updated_at = now()
+  FROM subject s
+ WHERE q.id = $1
+   AND s.id = q.subject_id
+   AND q.likes + $2 >= 0
+   AND q.deleted_at IS NULL
+   AND s.deleted_at IS NULL
+   AND s.closed_at IS NULL
+   AND s.enabled
+   AND (s.opens_at IS NULL OR s.opens_at <= now())
+   AND (s.closes_at IS NULL OR s.closes_at > now())
+RETURNING q.subject_id, q.likes`
@@ -25,10 +25,26 @@ const _ = grpc.SupportPackageIsVersion7
 type BoardClient interface {
 	ListSubjects(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SubjectList, error)
 	GetSubject(ctx context.Context, in *SubjectId, opts ...grpc.CallOption) (*Subject, error)
-	ListQuestions(ctx context.Context, in *SubjectId, opts ...grpc.CallOption) (*QuestionList, error)
-	CreateQuestion(ctx context.Context, in *NewQuestion, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ScheduleSubject(ctx context.Context, in *SubjectSchedule, opts ...grpc.CallOption) (*Subject, error)
+	WatchSubjects(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Board_WatchSubjectsClient, error)
+	CloseSubject(ctx context.Context, in *SubjectId, opts ...grpc.CallOption) (*Subject, error)
+	DeleteSubject(ctx context.Context, in *SubjectId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreSubject(ctx context.Context, in *SubjectId, opts ...grpc.CallOption) (*Subject, error)
+	GetFinalRanking(ctx context.Context, in *SubjectId, opts ...grpc.CallOption) (*Ranking, error)
+	ListQuestions(ctx context.Context, in *QuestionFilter, opts ...grpc.CallOption) (*QuestionList, error)
+	CreateQuestion(ctx context.Context, in *NewQuestion, opts ...grpc.CallOption) (*Question, error)
+	UpdateQuestion(ctx context.Context, in *EditedQuestion, opts ...grpc.CallOption) (*Question, error)
+	DeleteQuestion(ctx context.Context, in *QuestionId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RestoreQuestion(ctx context.Context, in *QuestionId, opts ...grpc.CallOption) (*Question, error)
+	ListQuestionEdits(ctx context.Context, in *QuestionId, opts ...grpc.CallOption) (*QuestionEditList, error)
+	SearchQuestions(ctx context.Context, in *QuestionQuery, opts ...grpc.CallOption) (*QuestionMatchList, error)
+	SetQuestionTags(ctx context.Context, in *QuestionTags, opts ...grpc.CallOption) (*Question, error)
+	ListTags(ctx context.Context, in *SubjectId, opts ...grpc.CallOption) (*TagList, error)
+	CreateTag(ctx context.Context, in *NewTag, opts ...grpc.CallOption) (*Tag, error)
+	DeleteTag(ctx context.Context, in *TagId, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Like(ctx context.Context, in *QuestionId, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Unlike(ctx context.Context, in *QuestionId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListAuditLog(ctx context.Context, in *AuditLogFilter, opts ...grpc.CallOption) (*AuditLogList, error)
 }
 
 type boardClient struct {
@@ -57,7 +73,84 @@ func (c *boardClient) GetSubject(ctx context.Context, in *SubjectId, opts ...grp
 	return out, nil
 }
 
-func (c *boardClient) ListQuestions(ctx context.Context, in *SubjectId, opts ...grpc.CallOption) (*QuestionList, error) {
+func (c *boardClient) ScheduleSubject(ctx context.Context, in *SubjectSchedule, opts ...grpc.CallOption) (*Subject, error) {
+	out := new(Subject)
+	err := c.cc.Invoke(ctx, "/board.Board/ScheduleSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) WatchSubjects(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Board_WatchSubjectsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Board_ServiceDesc.Streams[0], "/board.Board/WatchSubjects", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &boardWatchSubjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Board_WatchSubjectsClient interface {
+	Recv() (*SubjectEvent, error)
+	grpc.ClientStream
+}
+
+type boardWatchSubjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *boardWatchSubjectsClient) Recv() (*SubjectEvent, error) {
+	m := new(SubjectEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *boardClient) CloseSubject(ctx context.Context, in *SubjectId, opts ...grpc.CallOption) (*Subject, error) {
+	out := new(Subject)
+	err := c.cc.Invoke(ctx, "/board.Board/CloseSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) DeleteSubject(ctx context.Context, in *SubjectId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/board.Board/DeleteSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) RestoreSubject(ctx context.Context, in *SubjectId, opts ...grpc.CallOption) (*Subject, error) {
+	out := new(Subject)
+	err := c.cc.Invoke(ctx, "/board.Board/RestoreSubject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) GetFinalRanking(ctx context.Context, in *SubjectId, opts ...grpc.CallOption) (*Ranking, error) {
+	out := new(Ranking)
+	err := c.cc.Invoke(ctx, "/board.Board/GetFinalRanking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) ListQuestions(ctx context.Context, in *QuestionFilter, opts ...grpc.CallOption) (*QuestionList, error) {
 	out := new(QuestionList)
 	err := c.cc.Invoke(ctx, "/board.Board/ListQuestions", in, out, opts...)
 	if err != nil {
@@ -66,8 +159,8 @@ func (c *boardClient) ListQuestions(ctx context.Context, in *SubjectId, opts ...
 	return out, nil
 }
 
-func (c *boardClient) CreateQuestion(ctx context.Context, in *NewQuestion, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *boardClient) CreateQuestion(ctx context.Context, in *NewQuestion, opts ...grpc.CallOption) (*Question, error) {
+	out := new(Question)
 	err := c.cc.Invoke(ctx, "/board.Board/CreateQuestion", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -75,6 +168,87 @@ func (c *boardClient) CreateQuestion(ctx context.Context, in *NewQuestion, opts
 	return out, nil
 }
 
+func (c *boardClient) UpdateQuestion(ctx context.Context, in *EditedQuestion, opts ...grpc.CallOption) (*Question, error) {
+	out := new(Question)
+	err := c.cc.Invoke(ctx, "/board.Board/UpdateQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) DeleteQuestion(ctx context.Context, in *QuestionId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/board.Board/DeleteQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) RestoreQuestion(ctx context.Context, in *QuestionId, opts ...grpc.CallOption) (*Question, error) {
+	out := new(Question)
+	err := c.cc.Invoke(ctx, "/board.Board/RestoreQuestion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) ListQuestionEdits(ctx context.Context, in *QuestionId, opts ...grpc.CallOption) (*QuestionEditList, error) {
+	out := new(QuestionEditList)
+	err := c.cc.Invoke(ctx, "/board.Board/ListQuestionEdits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) SearchQuestions(ctx context.Context, in *QuestionQuery, opts ...grpc.CallOption) (*QuestionMatchList, error) {
+	out := new(QuestionMatchList)
+	err := c.cc.Invoke(ctx, "/board.Board/SearchQuestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) SetQuestionTags(ctx context.Context, in *QuestionTags, opts ...grpc.CallOption) (*Question, error) {
+	out := new(Question)
+	err := c.cc.Invoke(ctx, "/board.Board/SetQuestionTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) ListTags(ctx context.Context, in *SubjectId, opts ...grpc.CallOption) (*TagList, error) {
+	out := new(TagList)
+	err := c.cc.Invoke(ctx, "/board.Board/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) CreateTag(ctx context.Context, in *NewTag, opts ...grpc.CallOption) (*Tag, error) {
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, "/board.Board/CreateTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardClient) DeleteTag(ctx context.Context, in *TagId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/board.Board/DeleteTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boardClient) Like(ctx context.Context, in *QuestionId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/board.Board/Like", in, out, opts...)
@@ -93,16 +267,41 @@ func (c *boardClient) Unlike(ctx context.Context, in *QuestionId, opts ...grpc.C
 	return out, nil
 }
 
+func (c *boardClient) ListAuditLog(ctx context.Context, in *AuditLogFilter, opts ...grpc.CallOption) (*AuditLogList, error) {
+	out := new(AuditLogList)
+	err := c.cc.Invoke(ctx, "/board.Board/ListAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BoardServer is the server API for Board service.
 // All implementations must embed UnimplementedBoardServer
 // for forward compatibility
 type BoardServer interface {
 	ListSubjects(context.Context, *emptypb.Empty) (*SubjectList, error)
 	GetSubject(context.Context, *SubjectId) (*Subject, error)
-	ListQuestions(context.Context, *SubjectId) (*QuestionList, error)
-	CreateQuestion(context.Context, *NewQuestion) (*emptypb.Empty, error)
+	ScheduleSubject(context.Context, *SubjectSchedule) (*Subject, error)
+	WatchSubjects(*emptypb.Empty, Board_WatchSubjectsServer) error
+	CloseSubject(context.Context, *SubjectId) (*Subject, error)
+	DeleteSubject(context.Context, *SubjectId) (*emptypb.Empty, error)
+	RestoreSubject(context.Context, *SubjectId) (*Subject, error)
+	GetFinalRanking(context.Context, *SubjectId) (*Ranking, error)
+	ListQuestions(context.Context, *QuestionFilter) (*QuestionList, error)
+	CreateQuestion(context.Context, *NewQuestion) (*Question, error)
+	UpdateQuestion(context.Context, *EditedQuestion) (*Question, error)
+	DeleteQuestion(context.Context, *QuestionId) (*emptypb.Empty, error)
+	RestoreQuestion(context.Context, *QuestionId) (*Question, error)
+	ListQuestionEdits(context.Context, *QuestionId) (*QuestionEditList, error)
+	SearchQuestions(context.Context, *QuestionQuery) (*QuestionMatchList, error)
+	SetQuestionTags(context.Context, *QuestionTags) (*Question, error)
+	ListTags(context.Context, *SubjectId) (*TagList, error)
+	CreateTag(context.Context, *NewTag) (*Tag, error)
+	DeleteTag(context.Context, *TagId) (*emptypb.Empty, error)
 	Like(context.Context, *QuestionId) (*emptypb.Empty, error)
 	Unlike(context.Context, *QuestionId) (*emptypb.Empty, error)
+	ListAuditLog(context.Context, *AuditLogFilter) (*AuditLogList, error)
 	mustEmbedUnimplementedBoardServer()
 }
 
@@ -116,18 +315,66 @@ func (UnimplementedBoardServer) ListSubjects(context.Context, *emptypb.Empty) (*
 func (UnimplementedBoardServer) GetSubject(context.Context, *SubjectId) (*Subject, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSubject not implemented")
 }
-func (UnimplementedBoardServer) ListQuestions(context.Context, *SubjectId) (*QuestionList, error) {
+func (UnimplementedBoardServer) ScheduleSubject(context.Context, *SubjectSchedule) (*Subject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSubject not implemented")
+}
+func (UnimplementedBoardServer) WatchSubjects(*emptypb.Empty, Board_WatchSubjectsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSubjects not implemented")
+}
+func (UnimplementedBoardServer) CloseSubject(context.Context, *SubjectId) (*Subject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseSubject not implemented")
+}
+func (UnimplementedBoardServer) DeleteSubject(context.Context, *SubjectId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubject not implemented")
+}
+func (UnimplementedBoardServer) RestoreSubject(context.Context, *SubjectId) (*Subject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSubject not implemented")
+}
+func (UnimplementedBoardServer) GetFinalRanking(context.Context, *SubjectId) (*Ranking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFinalRanking not implemented")
+}
+func (UnimplementedBoardServer) ListQuestions(context.Context, *QuestionFilter) (*QuestionList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListQuestions not implemented")
 }
-func (UnimplementedBoardServer) CreateQuestion(context.Context, *NewQuestion) (*emptypb.Empty, error) {
+func (UnimplementedBoardServer) CreateQuestion(context.Context, *NewQuestion) (*Question, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateQuestion not implemented")
 }
+func (UnimplementedBoardServer) UpdateQuestion(context.Context, *EditedQuestion) (*Question, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateQuestion not implemented")
+}
+func (UnimplementedBoardServer) DeleteQuestion(context.Context, *QuestionId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuestion not implemented")
+}
+func (UnimplementedBoardServer) RestoreQuestion(context.Context, *QuestionId) (*Question, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreQuestion not implemented")
+}
+func (UnimplementedBoardServer) ListQuestionEdits(context.Context, *QuestionId) (*QuestionEditList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuestionEdits not implemented")
+}
+func (UnimplementedBoardServer) SearchQuestions(context.Context, *QuestionQuery) (*QuestionMatchList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchQuestions not implemented")
+}
+func (UnimplementedBoardServer) SetQuestionTags(context.Context, *QuestionTags) (*Question, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuestionTags not implemented")
+}
+func (UnimplementedBoardServer) ListTags(context.Context, *SubjectId) (*TagList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedBoardServer) CreateTag(context.Context, *NewTag) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTag not implemented")
+}
+func (UnimplementedBoardServer) DeleteTag(context.Context, *TagId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
+}
 func (UnimplementedBoardServer) Like(context.Context, *QuestionId) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Like not implemented")
 }
 func (UnimplementedBoardServer) Unlike(context.Context, *QuestionId) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unlike not implemented")
 }
+func (UnimplementedBoardServer) ListAuditLog(context.Context, *AuditLogFilter) (*AuditLogList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLog not implemented")
+}
 func (UnimplementedBoardServer) mustEmbedUnimplementedBoardServer() {}
 
 // UnsafeBoardServer may be embedded to opt out of forward compatibility for this service.
@@ -177,11 +424,122 @@ func _Board_GetSubject_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Board_ListQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Board_ScheduleSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubjectSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).ScheduleSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/ScheduleSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).ScheduleSubject(ctx, req.(*SubjectSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_WatchSubjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BoardServer).WatchSubjects(m, &boardWatchSubjectsServer{stream})
+}
+
+type Board_WatchSubjectsServer interface {
+	Send(*SubjectEvent) error
+	grpc.ServerStream
+}
+
+type boardWatchSubjectsServer struct {
+	grpc.ServerStream
+}
+
+func (x *boardWatchSubjectsServer) Send(m *SubjectEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Board_CloseSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubjectId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).CloseSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/CloseSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).CloseSubject(ctx, req.(*SubjectId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_DeleteSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubjectId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).DeleteSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/DeleteSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).DeleteSubject(ctx, req.(*SubjectId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_RestoreSubject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubjectId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).RestoreSubject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/RestoreSubject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).RestoreSubject(ctx, req.(*SubjectId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_GetFinalRanking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubjectId)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
+	if interceptor == nil {
+		return srv.(BoardServer).GetFinalRanking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/GetFinalRanking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).GetFinalRanking(ctx, req.(*SubjectId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_ListQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuestionFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
 	if interceptor == nil {
 		return srv.(BoardServer).ListQuestions(ctx, in)
 	}
@@ -190,7 +548,7 @@ func _Board_ListQuestions_Handler(srv interface{}, ctx context.Context, dec func
 		FullMethod: "/board.Board/ListQuestions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BoardServer).ListQuestions(ctx, req.(*SubjectId))
+		return srv.(BoardServer).ListQuestions(ctx, req.(*QuestionFilter))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -213,6 +571,168 @@ func _Board_CreateQuestion_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Board_UpdateQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditedQuestion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).UpdateQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/UpdateQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).UpdateQuestion(ctx, req.(*EditedQuestion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_DeleteQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuestionId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).DeleteQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/DeleteQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).DeleteQuestion(ctx, req.(*QuestionId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_RestoreQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuestionId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).RestoreQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/RestoreQuestion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).RestoreQuestion(ctx, req.(*QuestionId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_ListQuestionEdits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuestionId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).ListQuestionEdits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/ListQuestionEdits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).ListQuestionEdits(ctx, req.(*QuestionId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_SearchQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuestionQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).SearchQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/SearchQuestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).SearchQuestions(ctx, req.(*QuestionQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_SetQuestionTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuestionTags)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).SetQuestionTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/SetQuestionTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).SetQuestionTags(ctx, req.(*QuestionTags))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubjectId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).ListTags(ctx, req.(*SubjectId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_CreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewTag)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).CreateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/CreateTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).CreateTag(ctx, req.(*NewTag))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Board_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).DeleteTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/DeleteTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).DeleteTag(ctx, req.(*TagId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Board_Like_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuestionId)
 	if err := dec(in); err != nil {
@@ -249,6 +769,24 @@ func _Board_Unlike_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Board_ListAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServer).ListAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/board.Board/ListAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServer).ListAuditLog(ctx, req.(*AuditLogFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Board_ServiceDesc is the grpc.ServiceDesc for Board service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -264,6 +802,26 @@ var Board_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSubject",
 			Handler:    _Board_GetSubject_Handler,
 		},
+		{
+			MethodName: "ScheduleSubject",
+			Handler:    _Board_ScheduleSubject_Handler,
+		},
+		{
+			MethodName: "CloseSubject",
+			Handler:    _Board_CloseSubject_Handler,
+		},
+		{
+			MethodName: "DeleteSubject",
+			Handler:    _Board_DeleteSubject_Handler,
+		},
+		{
+			MethodName: "RestoreSubject",
+			Handler:    _Board_RestoreSubject_Handler,
+		},
+		{
+			MethodName: "GetFinalRanking",
+			Handler:    _Board_GetFinalRanking_Handler,
+		},
 		{
 			MethodName: "ListQuestions",
 			Handler:    _Board_ListQuestions_Handler,
@@ -272,6 +830,42 @@ var Board_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateQuestion",
 			Handler:    _Board_CreateQuestion_Handler,
 		},
+		{
+			MethodName: "UpdateQuestion",
+			Handler:    _Board_UpdateQuestion_Handler,
+		},
+		{
+			MethodName: "DeleteQuestion",
+			Handler:    _Board_DeleteQuestion_Handler,
+		},
+		{
+			MethodName: "RestoreQuestion",
+			Handler:    _Board_RestoreQuestion_Handler,
+		},
+		{
+			MethodName: "ListQuestionEdits",
+			Handler:    _Board_ListQuestionEdits_Handler,
+		},
+		{
+			MethodName: "SearchQuestions",
+			Handler:    _Board_SearchQuestions_Handler,
+		},
+		{
+			MethodName: "SetQuestionTags",
+			Handler:    _Board_SetQuestionTags_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _Board_ListTags_Handler,
+		},
+		{
+			MethodName: "CreateTag",
+			Handler:    _Board_CreateTag_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _Board_DeleteTag_Handler,
+		},
 		{
 			MethodName: "Like",
 			Handler:    _Board_Like_Handler,
@@ -280,7 +874,17 @@ var Board_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unlike",
 			Handler:    _Board_Unlike_Handler,
 		},
+		{
+			MethodName: "ListAuditLog",
+			Handler:    _Board_ListAuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSubjects",
+			Handler:       _Board_WatchSubjects_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "board.proto",
 }
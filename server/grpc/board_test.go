@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateQuestionLikesConcurrent(t *testing.T) {
+	db := openTestDB(t)
+
+	stmts, err := prepareBoardStatements(db, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmts.Close()
+
+	// want is -1 where unlikes may or may not find a like to take back,
+	// so only the applied calls add up
+	tests := []struct {
+		name    string
+		initial int64
+		likes   int
+		unlikes int
+		want    int64
+	}{
+		{"likes and unlikes", 150, 200, 150, 200},
+		{"unlikes stop at zero", 10, 0, 100, 0},
+		{"likes race unlikes at zero", 0, 100, 100, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, questionId := insertTestQuestion(t, db, tt.initial)
+
+			var wg sync.WaitGroup
+			var mu sync.Mutex
+			applied := map[int64]int64{}
+
+			run := func(delta int64, n int) {
+				for i := 0; i < n; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						_, ok, err := updateQuestionLikes(context.Background(), stmts, questionId, delta)
+						if err != nil {
+							t.Error(err)
+							return
+						}
+						if ok {
+							mu.Lock()
+							applied[delta]++
+							mu.Unlock()
+						}
+					}()
+				}
+			}
+			run(1, tt.likes)
+			run(-1, tt.unlikes)
+			wg.Wait()
+
+			likes, err := selectQuestionLikes(stmts, questionId)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if applied[1] != int64(tt.likes) {
+				t.Fatalf("%d of %d likes were applied", applied[1], tt.likes)
+			}
+			if want := tt.initial + applied[1] - applied[-1]; likes != want {
+				t.Fatalf("likes = %d, but %d likes and %d unlikes were applied to %d", likes, applied[1], applied[-1], tt.initial)
+			}
+			if tt.want >= 0 && likes != tt.want {
+				t.Fatalf("likes = %d, want %d", likes, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeletedSubjectIsHidden(t *testing.T) {
+	db := openTestDB(t)
+
+	stmts, err := prepareBoardStatements(db, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmts.Close()
+
+	subjectId, questionId := insertTestQuestion(t, db, 0)
+	if _, err := db.Exec("INSERT INTO question_edit(question_id, action, question, editor_id) VALUES ($1, 'create', 'q', '')", questionId); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := sentry.StartTransaction(context.Background(), "test")
+	defer tx.Finish()
+	ctx := context.WithValue(tx.Context(), DBSession, db)
+	board := &Board{stmts: stmts}
+
+	if _, err := board.GetSubject(ctx, &SubjectId{Id: subjectId}); err != nil {
+		t.Fatal(err)
+	}
+	if edits, err := board.ListQuestionEdits(ctx, &QuestionId{Id: questionId}); err != nil || len(edits.EditList) != 1 {
+		t.Fatalf("ListQuestionEdits = %v, %v, want the edit", edits, err)
+	}
+
+	if _, err := db.Exec("UPDATE subject SET deleted_at = now() WHERE id = $1", subjectId); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := board.GetSubject(ctx, &SubjectId{Id: subjectId}); status.Code(err) != codes.NotFound {
+		t.Fatalf("GetSubject = %v, want NotFound", err)
+	}
+	if _, err := board.GetSubject(ctx, &SubjectId{Id: -1}); status.Code(err) != codes.NotFound {
+		t.Fatalf("GetSubject of a missing subject = %v, want NotFound", err)
+	}
+	if edits, err := board.ListQuestionEdits(ctx, &QuestionId{Id: questionId}); err != nil || len(edits.EditList) != 0 {
+		t.Fatalf("ListQuestionEdits = %v, %v, want no edits of a deleted subject", edits, err)
+	}
+}
@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"sync"
+)
+
+const subscriberBuffer = 64
+
+type broker[T any] struct {
+	mu   sync.Mutex
+	subs map[chan T]struct{}
+}
+
+func newBroker[T any]() *broker[T] {
+	return &broker[T]{
+		subs: make(map[chan T]struct{}),
+	}
+}
+
+func (b *broker[T]) subscribe() chan T {
+	ch := make(chan T, subscriberBuffer)
+
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch
+}
+
+func (b *broker[T]) unsubscribe(ch chan T) {
+	b.mu.Lock()
+	delete(b.subs, ch)
+	b.mu.Unlock()
+}
+
+// publish never blocks; a subscriber that falls behind misses the event.
+func (b *broker[T]) publish(v T) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs {
+		select {
+		case ch <- v:
+		default:
+		}
+	}
+}
@@ -0,0 +1,312 @@
+package grpc
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	// external packages
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+)
+
+// Cache stores serialized responses. Entries are grouped by key, so one
+// Delete drops every variant of it, e.g. each tag filter of a subject.
+//
+// Every Delete also bumps the generation of its keys. Get returns the
+// generation it saw, and Set stores nothing once the key has moved past it,
+// so a value loaded before a write is never stored after the write deleted
+// the key, even when the two happen on different server instances.
+type Cache interface {
+	Get(ctx context.Context, key, field string) ([]byte, uint64, bool, error)
+	Set(ctx context.Context, key, field string, generation uint64, value []byte) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+type memoryEntry struct {
+	key     string
+	field   string
+	value   []byte
+	expires time.Time
+}
+
+// MemoryCache is a Cache that keeps up to size entries in process and
+// evicts the least recently used one, or any entry older than ttl.
+type MemoryCache struct {
+	size int
+	ttl  time.Duration
+
+	mu          sync.Mutex
+	lru         *list.List
+	entries     map[string]map[string]*list.Element
+	generations map[string]uint64
+}
+
+func NewMemoryCache(size int, ttl time.Duration) *MemoryCache {
+	return &MemoryCache{
+		size:        size,
+		ttl:         ttl,
+		lru:         list.New(),
+		entries:     make(map[string]map[string]*list.Element),
+		generations: make(map[string]uint64),
+	}
+}
+
+func (c *MemoryCache) Get(_ context.Context, key, field string) ([]byte, uint64, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	generation := c.generations[key]
+	e, ok := c.entries[key][field]
+	if !ok {
+		return nil, generation, false, nil
+	}
+	entry := e.Value.(*memoryEntry)
+	if c.ttl > 0 && time.Now().After(entry.expires) {
+		c.remove(e)
+		return nil, generation, false, nil
+	}
+
+	c.lru.MoveToFront(e)
+	return entry.value, generation, true, nil
+}
+
+func (c *MemoryCache) Set(_ context.Context, key, field string, generation uint64, value []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations[key] != generation {
+		return nil
+	}
+
+	expires := time.Now().Add(c.ttl)
+
+	if e, ok := c.entries[key][field]; ok {
+		entry := e.Value.(*memoryEntry)
+		entry.value = value
+		entry.expires = expires
+		c.lru.MoveToFront(e)
+		return nil
+	}
+
+	fields := c.entries[key]
+	if fields == nil {
+		fields = make(map[string]*list.Element)
+		c.entries[key] = fields
+	}
+	fields[field] = c.lru.PushFront(&memoryEntry{key: key, field: field, value: value, expires: expires})
+
+	for c.size > 0 && c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+	}
+	return nil
+}
+
+func (c *MemoryCache) Delete(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		for _, e := range c.entries[key] {
+			c.lru.Remove(e)
+		}
+		delete(c.entries, key)
+		c.generations[key]++
+	}
+	return nil
+}
+
+func (c *MemoryCache) remove(e *list.Element) {
+	entry := c.lru.Remove(e).(*memoryEntry)
+	if fields := c.entries[entry.key]; fields != nil {
+		delete(fields, entry.field)
+		if len(fields) == 0 {
+			delete(c.entries, entry.key)
+		}
+	}
+}
+
+// RedisCache is a Cache shared by every server instance. A key is a Redis
+// hash with one field per variant, and expires ttl after its last write.
+// Its generation is a counter next to it that never expires, so it cannot
+// start over while a load that saw it is still running. Both share a hash
+// tag to stay on one Redis Cluster slot.
+type RedisCache struct {
+	client redis.UniversalClient
+	prefix string
+	ttl    time.Duration
+}
+
+func NewRedisCache(client redis.UniversalClient, prefix string, ttl time.Duration) *RedisCache {
+	return &RedisCache{
+		client: client,
+		prefix: prefix,
+		ttl:    ttl,
+	}
+}
+
+func (c *RedisCache) Get(ctx context.Context, key, field string) ([]byte, uint64, bool, error) {
+	var value *redis.StringCmd
+	var generation *redis.StringCmd
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		value = pipe.HGet(ctx, c.hashKey(key), field)
+		generation = pipe.Get(ctx, c.generationKey(key))
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, 0, false, err
+	}
+
+	gen, err := generation.Uint64()
+	if err != nil && err != redis.Nil {
+		return nil, 0, false, err
+	}
+	data, err := value.Bytes()
+	if err == redis.Nil {
+		return nil, gen, false, nil
+	}
+	if err != nil {
+		return nil, 0, false, err
+	}
+	return data, gen, true, nil
+}
+
+// setScript stores a field only while the generation is still ARGV[1].
+var setScript = redis.NewScript(`
+if (redis.call('GET', KEYS[2]) or '0') ~= ARGV[1] then
+  return 0
+end
+redis.call('HSET', KEYS[1], ARGV[2], ARGV[3])
+if ARGV[4] ~= '0' then
+  redis.call('PEXPIRE', KEYS[1], ARGV[4])
+end
+return 1
+`)
+
+func (c *RedisCache) Set(ctx context.Context, key, field string, generation uint64, value []byte) error {
+	keys := []string{c.hashKey(key), c.generationKey(key)}
+	return setScript.Run(ctx, c.client, keys, generation, field, value, c.ttl.Milliseconds()).Err()
+}
+
+func (c *RedisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, key := range keys {
+			pipe.Incr(ctx, c.generationKey(key))
+			pipe.Del(ctx, c.hashKey(key))
+		}
+		return nil
+	})
+	return err
+}
+
+func (c *RedisCache) hashKey(key string) string {
+	return c.prefix + "{" + key + "}"
+}
+
+func (c *RedisCache) generationKey(key string) string {
+	return c.prefix + "{" + key + "}:generation"
+}
+
+const subjectsCacheKey = "subjects"
+
+func questionsCacheKey(subjectId int64) string {
+	return "questions:" + strconv.FormatInt(subjectId, 10)
+}
+
+// boardCache reads ListSubjects and ListQuestions through a Cache. Concurrent
+// misses of the same entry share one query. A cache failure is logged and
+// falls back to the database.
+//
+// A query is keyed by the generation the cache returned with the miss, so
+// a query that was started before a write neither stores its result nor is
+// joined by the callers that come after the write.
+type boardCache struct {
+	cache Cache
+	group singleflight.Group
+}
+
+func newBoardCache(cache Cache) *boardCache {
+	return &boardCache{
+		cache: cache,
+	}
+}
+
+func (c *boardCache) subjects(ctx context.Context, load func(context.Context) (*SubjectList, error)) (*SubjectList, error) {
+	list := &SubjectList{}
+	err := c.get(ctx, subjectsCacheKey, "", list, func(ctx context.Context) (proto.Message, error) {
+		return load(ctx)
+	})
+	return list, err
+}
+
+func (c *boardCache) questions(ctx context.Context, filter *QuestionFilter, load func(context.Context) (*QuestionList, error)) (*QuestionList, error) {
+	tags := uniqueTags(filter.Tags)
+	sort.Strings(tags)
+	field := strconv.FormatBool(filter.MatchAll) + ":" + strings.Join(tags, ",")
+
+	list := &QuestionList{}
+	err := c.get(ctx, questionsCacheKey(filter.SubjectId), field, list, func(ctx context.Context) (proto.Message, error) {
+		return load(ctx)
+	})
+	return list, err
+}
+
+// get unmarshals into m so every caller owns its copy of the response.
+func (c *boardCache) get(ctx context.Context, key, field string, m proto.Message, load func(context.Context) (proto.Message, error)) error {
+	data, generation, ok, err := c.cache.Get(ctx, key, field)
+	if err != nil {
+		loggerFromContext(ctx).Warnf("BoardCache: failed to get '%s'. %s", key, err)
+	}
+	if ok {
+		if err := proto.Unmarshal(data, m); err == nil {
+			return nil
+		}
+	}
+	// without a generation there is no telling whether a write came first
+	store := err == nil
+
+	flight := fmt.Sprintf("%s\x00%d\x00%s", key, generation, field)
+
+	v, err, _ := c.group.Do(flight, func() (interface{}, error) {
+		// the load is shared by every waiting caller, so it must not be
+		// canceled along with the first one
+		bg := context.Background()
+
+		m, err := load(bg)
+		if err != nil {
+			return nil, err
+		}
+		data, err := proto.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+		if !store {
+			return data, nil
+		}
+		if err := c.cache.Set(bg, key, field, generation, data); err != nil {
+			loggerFromContext(ctx).Warnf("BoardCache: failed to set '%s'. %s", key, err)
+		}
+		return data, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return proto.Unmarshal(v.([]byte), m)
+}
+
+func (c *boardCache) invalidate(ctx context.Context, keys ...string) {
+	if err := c.cache.Delete(ctx, keys...); err != nil {
+		loggerFromContext(ctx).Errorf("BoardCache: failed to delete %v. %s", keys, err)
+	}
+}
@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	// external packages
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestRedisCaches returns n RedisCaches on one miniredis, as n server
+// instances would share one Redis.
+func newTestRedisCaches(t *testing.T, n int, ttl time.Duration) (*miniredis.Miniredis, []Cache) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	caches := make([]Cache, n)
+	for i := range caches {
+		client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+		t.Cleanup(func() { client.Close() })
+		caches[i] = NewRedisCache(client, "test:", ttl)
+	}
+	return server, caches
+}
+
+func testCaches(t *testing.T) map[string]Cache {
+	_, redisCaches := newTestRedisCaches(t, 1, time.Minute)
+	return map[string]Cache{
+		"memory": NewMemoryCache(0, time.Minute),
+		"redis":  redisCaches[0],
+	}
+}
+
+func TestCacheGeneration(t *testing.T) {
+	ctx := context.Background()
+
+	for name, cache := range testCaches(t) {
+		t.Run(name, func(t *testing.T) {
+			_, generation, ok, err := cache.Get(ctx, "k", "a")
+			if ok || err != nil {
+				t.Fatalf("Get = %v, %v, want a miss", ok, err)
+			}
+			if err := cache.Set(ctx, "k", "a", generation, []byte("1")); err != nil {
+				t.Fatal(err)
+			}
+			if value, _, ok, err := cache.Get(ctx, "k", "a"); !ok || err != nil || string(value) != "1" {
+				t.Fatalf("Get = %q, %v, %v, want the stored value", value, ok, err)
+			}
+
+			if err := cache.Delete(ctx, "k"); err != nil {
+				t.Fatal(err)
+			}
+			// a value loaded before the Delete
+			if err := cache.Set(ctx, "k", "b", generation, []byte("stale")); err != nil {
+				t.Fatal(err)
+			}
+			_, next, ok, err := cache.Get(ctx, "k", "b")
+			if ok || err != nil {
+				t.Fatalf("Get = %v, %v, want the stale value refused", ok, err)
+			}
+			if next == generation {
+				t.Fatalf("generation stayed at %d after Delete", next)
+			}
+
+			if err := cache.Set(ctx, "k", "b", next, []byte("2")); err != nil {
+				t.Fatal(err)
+			}
+			if value, _, ok, err := cache.Get(ctx, "k", "b"); !ok || err != nil || string(value) != "2" {
+				t.Fatalf("Get = %q, %v, %v, want the stored value", value, ok, err)
+			}
+		})
+	}
+}
+
+func TestRedisCacheExpiry(t *testing.T) {
+	ctx := context.Background()
+	server, caches := newTestRedisCaches(t, 1, time.Minute)
+	cache := caches[0]
+
+	_, generation, _, _ := cache.Get(ctx, "k", "a")
+	cache.Delete(ctx, "k")
+	_, next, _, _ := cache.Get(ctx, "k", "a")
+	if err := cache.Set(ctx, "k", "a", next, []byte("1")); err != nil {
+		t.Fatal(err)
+	}
+
+	server.FastForward(2 * time.Minute)
+	if _, _, ok, _ := cache.Get(ctx, "k", "a"); ok {
+		t.Fatal("value outlived its ttl")
+	}
+	// the generation does not expire, so an old load still can't store
+	cache.Set(ctx, "k", "a", generation, []byte("stale"))
+	if _, _, ok, _ := cache.Get(ctx, "k", "a"); ok {
+		t.Fatal("a load from before the Delete was stored after the expiry")
+	}
+}
+
+func TestMemoryCacheEviction(t *testing.T) {
+	ctx := context.Background()
+	cache := NewMemoryCache(2, time.Minute)
+
+	cache.Set(ctx, "k", "a", 0, []byte("a"))
+	cache.Set(ctx, "k", "b", 0, []byte("b"))
+	cache.Get(ctx, "k", "a")
+	cache.Set(ctx, "k", "c", 0, []byte("c"))
+
+	for field, want := range map[string]bool{"a": true, "b": false, "c": true} {
+		if _, _, ok, _ := cache.Get(ctx, "k", field); ok != want {
+			t.Fatalf("field %s cached = %v, want %v", field, ok, want)
+		}
+	}
+}
+
+// TestBoardCacheWriteDuringLoad has one instance load a subject list while
+// another one writes and invalidates it.
+func TestBoardCacheWriteDuringLoad(t *testing.T) {
+	_, redisCaches := newTestRedisCaches(t, 2, time.Minute)
+	memory := NewMemoryCache(0, time.Minute)
+
+	tests := []struct {
+		name   string
+		reader Cache
+		writer Cache
+	}{
+		{"memory", memory, memory},
+		{"redis across instances", redisCaches[0], redisCaches[1]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			reader := newBoardCache(tt.reader)
+			writer := newBoardCache(tt.writer)
+
+			title := "before"
+			started := make(chan struct{})
+			written := make(chan struct{})
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				reader.subjects(ctx, func(context.Context) (*SubjectList, error) {
+					list := &SubjectList{SubjectList: []*Subject{{Title: title}}}
+					close(started)
+					<-written
+					return list, nil
+				})
+			}()
+
+			<-started
+			title = "after"
+			writer.invalidate(ctx, subjectsCacheKey)
+			close(written)
+			<-done
+
+			list, err := writer.subjects(ctx, func(context.Context) (*SubjectList, error) {
+				return &SubjectList{SubjectList: []*Subject{{Title: title}}}, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := &SubjectList{SubjectList: []*Subject{{Title: "after"}}}
+			if !proto.Equal(list, want) {
+				t.Fatalf("subjects = %v, want %v", list, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	dateLayout  = "2006-01-02"
+	clockLayout = "15:04"
+
+	calendarLookahead = 366
+)
+
+type calendarFile struct {
+	Timezone string            `json:"timezone"`
+	PreOpen  string            `json:"pre_open"`
+	Open     string            `json:"open"`
+	Close    string            `json:"close"`
+	Weekdays []string          `json:"weekdays"`
+	Holidays []string          `json:"holidays"`
+	HalfDays map[string]string `json:"half_days"`
+}
+
+type clock struct {
+	hour   int
+	minute int
+}
+
+type sessionTransition struct {
+	state SessionState
+	at    time.Time
+}
+
+// MarketCalendar tells the trading session at any time. A nil calendar
+// keeps the market open all the time.
+type MarketCalendar struct {
+	location *time.Location
+	preOpen  *clock
+	open     clock
+	close    clock
+	weekdays map[time.Weekday]bool
+	holidays map[string]bool
+	halfDays map[string]clock
+}
+
+// LoadMarketCalendar reads a JSON file like
+//
+//	{
+//	  "timezone": "Asia/Seoul",
+//	  "pre_open": "08:30",
+//	  "open": "09:00",
+//	  "close": "15:30",
+//	  "weekdays": ["Mon", "Tue", "Wed", "Thu", "Fri"],
+//	  "holidays": ["2026-01-01"],
+//	  "half_days": {"2026-12-31": "12:00"}
+//	}
+//
+// where half_days maps a date to its early close.
+func LoadMarketCalendar(path string) (*MarketCalendar, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file calendarFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	c := &MarketCalendar{
+		location: time.UTC,
+		weekdays: make(map[time.Weekday]bool),
+		holidays: make(map[string]bool),
+		halfDays: make(map[string]clock),
+	}
+
+	if file.Timezone != "" {
+		if c.location, err = time.LoadLocation(file.Timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.open, err = parseClock(file.Open); err != nil {
+		return nil, fmt.Errorf("invalid open: %w", err)
+	}
+	if c.close, err = parseClock(file.Close); err != nil {
+		return nil, fmt.Errorf("invalid close: %w", err)
+	}
+	if !c.open.before(c.close) {
+		return nil, fmt.Errorf("open %s is not before close %s", file.Open, file.Close)
+	}
+	if file.PreOpen != "" {
+		preOpen, err := parseClock(file.PreOpen)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pre_open: %w", err)
+		}
+		if !preOpen.before(c.open) {
+			return nil, fmt.Errorf("pre_open %s is not before open %s", file.PreOpen, file.Open)
+		}
+		c.preOpen = &preOpen
+	}
+
+	weekdays := file.Weekdays
+	if len(weekdays) == 0 {
+		weekdays = []string{"Mon", "Tue", "Wed", "Thu", "Fri"}
+	}
+	for _, w := range weekdays {
+		day, ok := parseWeekday(w)
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %q", w)
+		}
+		c.weekdays[day] = true
+	}
+
+	for _, d := range file.Holidays {
+		if _, err := time.Parse(dateLayout, d); err != nil {
+			return nil, fmt.Errorf("invalid holiday: %w", err)
+		}
+		c.holidays[d] = true
+	}
+
+	for d, v := range file.HalfDays {
+		if _, err := time.Parse(dateLayout, d); err != nil {
+			return nil, fmt.Errorf("invalid half day: %w", err)
+		}
+		closeAt, err := parseClock(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid half day close: %w", err)
+		}
+		if !c.open.before(closeAt) {
+			return nil, fmt.Errorf("half day %s closes before open", d)
+		}
+		c.halfDays[d] = closeAt
+	}
+
+	return c, nil
+}
+
+func (c *MarketCalendar) State(t time.Time) SessionState {
+	if c == nil {
+		return SessionState_SESSION_OPEN
+	}
+
+	state := SessionState_SESSION_CLOSED
+	for _, tr := range c.transitions(t) {
+		if t.Before(tr.at) {
+			break
+		}
+		state = tr.state
+	}
+	return state
+}
+
+// Next returns the first transition after t, or a zero time if the calendar
+// has no trading day within a year.
+func (c *MarketCalendar) Next(t time.Time) (SessionState, time.Time) {
+	if c == nil {
+		return SessionState_SESSION_STATE_UNSPECIFIED, time.Time{}
+	}
+
+	day := t.In(c.location)
+	for i := 0; i < calendarLookahead; i++ {
+		for _, tr := range c.transitions(day) {
+			if tr.at.After(t) {
+				return tr.state, tr.at
+			}
+		}
+		day = time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, c.location)
+	}
+	return SessionState_SESSION_STATE_UNSPECIFIED, time.Time{}
+}
+
+func (c *MarketCalendar) session(t time.Time) *MarketSession {
+	session := &MarketSession{
+		State: c.State(t),
+		Time:  timestamppb.New(t),
+	}
+	if next, at := c.Next(t); !at.IsZero() {
+		session.NextState = next
+		session.NextAt = timestamppb.New(at)
+	}
+	return session
+}
+
+// transitions lists the session changes of the local day containing t.
+func (c *MarketCalendar) transitions(t time.Time) []sessionTransition {
+	local := t.In(c.location)
+	date := local.Format(dateLayout)
+	if !c.weekdays[local.Weekday()] || c.holidays[date] {
+		return nil
+	}
+
+	closeAt := c.close
+	if half, ok := c.halfDays[date]; ok {
+		closeAt = half
+	}
+
+	var list []sessionTransition
+	if c.preOpen != nil {
+		list = append(list, sessionTransition{SessionState_SESSION_PRE_OPEN, c.preOpen.on(local)})
+	}
+	list = append(list,
+		sessionTransition{SessionState_SESSION_OPEN, c.open.on(local)},
+		sessionTransition{SessionState_SESSION_CLOSED, closeAt.on(local)},
+	)
+	return list
+}
+
+func (t *Trading) GetMarketSession(ctx context.Context, _ *emptypb.Empty) (*MarketSession, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/trading.Trading/GetMarketSession")
+	defer span.Finish()
+
+	return t.calendar.session(time.Now()), nil
+}
+
+func (t *Trading) StreamMarketSessions(_ *emptypb.Empty, stream Trading_StreamMarketSessionsServer) error {
+	sessions := t.sessions.subscribe()
+	defer t.sessions.unsubscribe(sessions)
+
+	if err := stream.Send(t.calendar.session(time.Now())); err != nil {
+		loggerFromContext(stream.Context()).WithError(err).Error("StreamMarketSessions")
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case session := <-sessions:
+			if err := stream.Send(session); err != nil {
+				loggerFromContext(stream.Context()).WithError(err).Error("StreamMarketSessions")
+				return err
+			}
+		}
+	}
+}
+
+func (t *Trading) runSessions() {
+	for {
+		_, at := t.calendar.Next(time.Now())
+		if at.IsZero() {
+			log.Warnf("MarketCalendar: no session change within %d days", calendarLookahead)
+			return
+		}
+
+		timer := time.NewTimer(time.Until(at))
+		now := <-timer.C
+
+		session := t.calendar.session(now)
+		log.Infof("MarketCalendar: session is %s", session.State)
+		t.sessions.publish(session)
+	}
+}
+
+func checkSessionOpen(calendar *MarketCalendar, now time.Time) error {
+	if state := calendar.State(now); state != SessionState_SESSION_OPEN {
+		return status.Errorf(codes.FailedPrecondition, "market is not open (%s)", state)
+	}
+	return nil
+}
+
+func parseClock(value string) (clock, error) {
+	t, err := time.Parse(clockLayout, value)
+	if err != nil {
+		return clock{}, err
+	}
+	return clock{hour: t.Hour(), minute: t.Minute()}, nil
+}
+
+func (c clock) before(other clock) bool {
+	return c.hour*60+c.minute < other.hour*60+other.minute
+}
+
+func (c clock) on(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), c.hour, c.minute, 0, 0, day.Location())
+}
+
+func parseWeekday(value string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(value, d.String()) || strings.EqualFold(value, d.String()[:3]) {
+			return d, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,126 @@
+package grpc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func loadTestCalendar(t *testing.T, content string) (*MarketCalendar, error) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "calendar.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return LoadMarketCalendar(path)
+}
+
+func newTestCalendar(t *testing.T) *MarketCalendar {
+	t.Helper()
+
+	c, err := loadTestCalendar(t, `{
+  "timezone": "Asia/Seoul",
+  "pre_open": "08:30",
+  "open": "09:00",
+  "close": "15:30",
+  "holidays": ["2026-10-09"],
+  "half_days": {"2026-10-14": "12:00"}
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestMarketCalendarState(t *testing.T) {
+	c := newTestCalendar(t)
+	seoul, _ := time.LoadLocation("Asia/Seoul")
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, seoul)
+	}
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want SessionState
+	}{
+		{"before pre-open", at(13, 8, 29), SessionState_SESSION_CLOSED},
+		{"at pre-open", at(13, 8, 30), SessionState_SESSION_PRE_OPEN},
+		{"just before open", at(13, 8, 59), SessionState_SESSION_PRE_OPEN},
+		{"at open", at(13, 9, 0), SessionState_SESSION_OPEN},
+		{"just before close", at(13, 15, 29), SessionState_SESSION_OPEN},
+		{"at close", at(13, 15, 30), SessionState_SESSION_CLOSED},
+		{"half day morning", at(14, 11, 59), SessionState_SESSION_OPEN},
+		{"half day afternoon", at(14, 12, 0), SessionState_SESSION_CLOSED},
+		{"holiday", at(9, 10, 0), SessionState_SESSION_CLOSED},
+		{"saturday", at(17, 10, 0), SessionState_SESSION_CLOSED},
+		{"open in another zone", time.Date(2026, 10, 13, 1, 0, 0, 0, time.UTC), SessionState_SESSION_OPEN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.State(tt.t); got != tt.want {
+				t.Fatalf("State = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	var open *MarketCalendar
+	if got := open.State(at(17, 10, 0)); got != SessionState_SESSION_OPEN {
+		t.Fatalf("nil calendar State = %s, want open", got)
+	}
+}
+
+func TestMarketCalendarNext(t *testing.T) {
+	c := newTestCalendar(t)
+	seoul, _ := time.LoadLocation("Asia/Seoul")
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, seoul)
+	}
+
+	tests := []struct {
+		name  string
+		t     time.Time
+		state SessionState
+		at    time.Time
+	}{
+		{"pre-open of the day", at(13, 7, 0), SessionState_SESSION_PRE_OPEN, at(13, 8, 30)},
+		{"open after pre-open", at(13, 8, 30), SessionState_SESSION_OPEN, at(13, 9, 0)},
+		{"close of a half day", at(14, 10, 0), SessionState_SESSION_CLOSED, at(14, 12, 0)},
+		{"across the weekend", at(16, 16, 0), SessionState_SESSION_PRE_OPEN, at(19, 8, 30)},
+		{"across a holiday and the weekend", at(8, 16, 0), SessionState_SESSION_PRE_OPEN, at(12, 8, 30)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, next := c.Next(tt.t)
+			if state != tt.state || !next.Equal(tt.at) {
+				t.Fatalf("Next = %s at %s, want %s at %s", state, next, tt.state, tt.at)
+			}
+		})
+	}
+}
+
+func TestLoadMarketCalendarErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"open after close", `{"open": "16:00", "close": "15:30"}`},
+		{"pre-open after open", `{"pre_open": "09:30", "open": "09:00", "close": "15:30"}`},
+		{"unknown weekday", `{"open": "09:00", "close": "15:30", "weekdays": ["Mon", "Funday"]}`},
+		{"bad holiday", `{"open": "09:00", "close": "15:30", "holidays": ["10/09/2026"]}`},
+		{"half day closing before open", `{"open": "09:00", "close": "15:30", "half_days": {"2026-10-14": "08:00"}}`},
+		{"unknown timezone", `{"timezone": "Mars/Olympus", "open": "09:00", "close": "15:30"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := loadTestCalendar(t, tt.content); err == nil {
+				t.Fatal("LoadMarketCalendar = nil, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,406 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var candlePeriods = map[string]time.Duration{
+	"1m": time.Minute,
+	"5m": 5 * time.Minute,
+	"1h": time.Hour,
+	"1d": 24 * time.Hour,
+}
+
+type candleKey struct {
+	code   string
+	period string
+	start  int64
+}
+
+// CandleAggregator rolls quotes into OHLCV candles in memory and flushes
+// them into the candle table. Pending candles only hold the ticks seen since
+// the last flush and are merged into the stored rows, so restarting in the
+// middle of a bucket keeps what was already written.
+type CandleAggregator struct {
+	db *sql.DB
+
+	// flushMu keeps Flush from writing candles while Rebuild replaces them
+	flushMu sync.Mutex
+
+	mu      sync.Mutex
+	pending map[candleKey]*Candle
+	latest  time.Time
+}
+
+func NewCandleAggregator(db *sql.DB) *CandleAggregator {
+	return &CandleAggregator{
+		db:      db,
+		pending: make(map[candleKey]*Candle),
+	}
+}
+
+func (a *CandleAggregator) Run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := a.Flush(); err != nil {
+			sentry.CaptureException(err)
+			log.Errorf("CandleAggregator: failed to flush candles. %s", err)
+		}
+	}
+}
+
+func (a *CandleAggregator) add(quotes []*Quote) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, q := range quotes {
+		if t := q.Time.AsTime(); t.After(a.latest) {
+			a.latest = t
+		}
+		for period, d := range candlePeriods {
+			start := q.Time.AsTime().Truncate(d)
+			key := candleKey{code: q.Code, period: period, start: start.Unix()}
+
+			c, ok := a.pending[key]
+			if !ok {
+				a.pending[key] = &Candle{
+					Code:     q.Code,
+					Interval: period,
+					Start:    timestamppb.New(start),
+					Open:     q.Last,
+					High:     q.Last,
+					Low:      q.Last,
+					Close:    q.Last,
+					Volume:   q.Volume,
+				}
+				continue
+			}
+			mergeCandle(c, &Candle{High: q.Last, Low: q.Last, Close: q.Last, Volume: q.Volume})
+		}
+	}
+}
+
+func (a *CandleAggregator) Flush() error {
+	a.flushMu.Lock()
+	defer a.flushMu.Unlock()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(a.pending) == 0 {
+		return nil
+	}
+
+	list := make([]*Candle, 0, len(a.pending))
+	for _, c := range a.pending {
+		list = append(list, c)
+	}
+	if err := upsertCandles(a.db, list); err != nil {
+		return err
+	}
+
+	a.pending = make(map[candleKey]*Candle)
+	return nil
+}
+
+func (a *CandleAggregator) Candles(ctx context.Context, code, period string, from, to *timestamppb.Timestamp) ([]*Candle, error) {
+	d, ok := candlePeriods[period]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid 'interval' %q", period)
+	}
+	code = strings.ToUpper(code)
+	begin, end := candleRange(d, from, to)
+
+	list, err := selectCandles(ctx, a.db, code, period, begin, end)
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	byStart := make(map[int64]*Candle, len(list))
+	for _, c := range list {
+		byStart[c.Start.Seconds] = c
+	}
+	for key, p := range a.pending {
+		if key.code != code || key.period != period || key.start < begin.Unix() || key.start >= end.Unix() {
+			continue
+		}
+		if c, ok := byStart[key.start]; ok {
+			mergeCandle(c, p)
+			continue
+		}
+		list = append(list, proto.Clone(p).(*Candle))
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Start.Seconds < list[j].Start.Seconds })
+	return list, nil
+}
+
+// Rebuild replaces the candles in the range with ones aggregated from the
+// quote table. An empty code rebuilds every stock and an empty period
+// rebuilds every interval.
+//
+// Quotes are written before they reach add, so the pending ticks up to the
+// latest one are dropped and aggregated from the quote table instead, while
+// the ticks that arrive during the rebuild stay pending and are merged into
+// the rebuilt candles by the next Flush. a.mu is only held to split the
+// pending ticks, not while the candles are rebuilt.
+func (a *CandleAggregator) Rebuild(ctx context.Context, code, period string, from, to *timestamppb.Timestamp) error {
+	periods := []string{period}
+	if period == "" {
+		periods = make([]string, 0, len(candlePeriods))
+		for p := range candlePeriods {
+			periods = append(periods, p)
+		}
+	} else if _, ok := candlePeriods[period]; !ok {
+		return status.Errorf(codes.InvalidArgument, "invalid 'interval' %q", period)
+	}
+	code = strings.ToUpper(code)
+
+	a.flushMu.Lock()
+	defer a.flushMu.Unlock()
+
+	cutoff, dropped := a.dropPending(code, periods, from, to)
+
+	if err := a.rebuild(ctx, code, periods, from, to, cutoff); err != nil {
+		a.restorePending(dropped)
+		return err
+	}
+
+	return nil
+}
+
+func (a *CandleAggregator) rebuild(ctx context.Context, code string, periods []string, from, to *timestamppb.Timestamp, cutoff time.Time) error {
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, p := range periods {
+		d := candlePeriods[p]
+		begin, end := candleRange(d, from, to)
+
+		if err := rebuildCandles(ctx, tx, code, p, d, begin, end, cutoff); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// dropPending takes the pending candles in the range out and returns them
+// with the time of the latest tick they can hold.
+func (a *CandleAggregator) dropPending(code string, periods []string, from, to *timestamppb.Timestamp) (time.Time, map[candleKey]*Candle) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cutoff := a.latest
+	if cutoff.IsZero() {
+		cutoff = time.Now()
+	}
+
+	dropped := make(map[candleKey]*Candle)
+	for _, p := range periods {
+		begin, end := candleRange(candlePeriods[p], from, to)
+
+		for key, c := range a.pending {
+			if (code == "" || key.code == code) && key.period == p && key.start >= begin.Unix() && key.start < end.Unix() {
+				dropped[key] = c
+				delete(a.pending, key)
+			}
+		}
+	}
+
+	return cutoff, dropped
+}
+
+// restorePending puts back the candles of a failed rebuild, ahead of the
+// ticks that arrived since.
+func (a *CandleAggregator) restorePending(dropped map[candleKey]*Candle) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for key, c := range dropped {
+		if p, ok := a.pending[key]; ok {
+			mergeCandle(c, p)
+		}
+		a.pending[key] = c
+	}
+}
+
+func (s *Stocks) GetCandles(ctx context.Context, request *CandleRequest) (*CandleList, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/GetCandles")
+	defer span.Finish()
+
+	list, err := s.candles.Candles(ctx, request.Code, request.Interval, request.From, request.To)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetCandles")
+		return nil, err
+	}
+
+	return &CandleList{
+		CandleList: list,
+	}, nil
+}
+
+func (s *Stocks) RebuildCandles(ctx context.Context, request *CandleRequest) (*emptypb.Empty, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/RebuildCandles")
+	defer span.Finish()
+
+	if !principalFromContext(ctx).Moderator {
+		loggerFromContext(ctx).Errorf("RebuildCandles: permission denied")
+		return nil, status.Error(codes.PermissionDenied, "only a moderator can rebuild candles")
+	}
+
+	if err := s.candles.Rebuild(ctx, request.Code, request.Interval, request.From, request.To); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("RebuildCandles")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func selectCandles(ctx context.Context, db *sql.DB, code, period string, begin, end time.Time) ([]*Candle, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT c.start, c.open, c.high, c.low, c.close, c.volume
+  FROM candle c
+  JOIN stocks s ON s.id = c.stock_id
+ WHERE s.code = $1 AND c.period = $2 AND c.start >= $3 AND c.start < $4
+ ORDER BY c.start`,
+		code, period, begin, end)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Candle
+
+	for rows.Next() {
+		var start time.Time
+		c := &Candle{Code: code, Interval: period}
+		if err := rows.Scan(&start, &c.Open, &c.High, &c.Low, &c.Close, &c.Volume); err != nil {
+			return nil, err
+		}
+		c.Start = timestamppb.New(start)
+		list = append(list, c)
+	}
+
+	return list, rows.Err()
+}
+
+func upsertCandles(db *sql.DB, list []*Candle) error {
+	n := len(list)
+	stockCodes := make([]string, n)
+	periods := make([]string, n)
+	starts := make([]time.Time, n)
+	opens := make([]float64, n)
+	highs := make([]float64, n)
+	lows := make([]float64, n)
+	closes := make([]float64, n)
+	volumes := make([]int64, n)
+	for i, c := range list {
+		stockCodes[i] = c.Code
+		periods[i] = c.Interval
+		starts[i] = c.Start.AsTime()
+		opens[i] = c.Open
+		highs[i] = c.High
+		lows[i] = c.Low
+		closes[i] = c.Close
+		volumes[i] = c.Volume
+	}
+
+	_, err := db.Exec(`
+INSERT INTO candle(stock_id, period, start, open, high, low, close, volume)
+SELECT s.id, c.period, c.start, c.open, c.high, c.low, c.close, c.volume
+  FROM unnest($1::varchar[], $2::varchar[], $3::timestamptz[], $4::float8[], $5::float8[], $6::float8[], $7::float8[], $8::bigint[])
+       AS c(code, period, start, open, high, low, close, volume)
+  JOIN stocks s ON s.code = c.code
+ON CONFLICT (stock_id, period, start) DO UPDATE
+   SET high   = GREATEST(candle.high, EXCLUDED.high),
+       low    = LEAST(candle.low, EXCLUDED.low),
+       close  = EXCLUDED.close,
+       volume = candle.volume + EXCLUDED.volume`,
+		pq.Array(stockCodes), pq.Array(periods), pq.Array(starts), pq.Array(opens),
+		pq.Array(highs), pq.Array(lows), pq.Array(closes), pq.Array(volumes))
+	return err
+}
+
+func rebuildCandles(ctx context.Context, tx *sql.Tx, code, period string, d time.Duration, begin, end, cutoff time.Time) error {
+	_, err := tx.ExecContext(ctx, `
+DELETE FROM candle
+ WHERE stock_id IN (SELECT id FROM stocks WHERE $1 = '' OR code = $1)
+   AND period = $2 AND start >= $3 AND start < $4`,
+		code, period, begin, end)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+INSERT INTO candle(stock_id, period, start, open, high, low, close, volume)
+SELECT stock_id, $2, bucket,
+       (array_agg(last ORDER BY time, id))[1],
+       max(last),
+       min(last),
+       (array_agg(last ORDER BY time DESC, id DESC))[1],
+       sum(volume)
+  FROM (SELECT id, stock_id, last, volume, time,
+               date_bin($5 * INTERVAL '1 second', time, TIMESTAMPTZ '2000-01-01 00:00:00+00') AS bucket
+          FROM quote
+         WHERE stock_id IN (SELECT id FROM stocks WHERE $1 = '' OR code = $1)
+           AND time >= $3 AND time < $4 AND time <= $6) q
+ GROUP BY stock_id, bucket`,
+		code, period, begin, end, d.Seconds(), cutoff)
+	return err
+}
+
+func mergeCandle(c, delta *Candle) {
+	if delta.High > c.High {
+		c.High = delta.High
+	}
+	if delta.Low < c.Low {
+		c.Low = delta.Low
+	}
+	c.Close = delta.Close
+	c.Volume += delta.Volume
+}
+
+// candleRange aligns [from, to) to whole buckets. A missing from reads from
+// the beginning and a missing to reads up to the current bucket.
+func candleRange(d time.Duration, from, to *timestamppb.Timestamp) (time.Time, time.Time) {
+	var begin time.Time
+	if from != nil {
+		begin = from.AsTime().Truncate(d)
+	}
+
+	end := time.Now().Truncate(d).Add(d)
+	if to != nil {
+		end = to.AsTime()
+		if t := end.Truncate(d); !t.Equal(end) {
+			end = t.Add(d)
+		}
+	}
+
+	return begin, end
+}
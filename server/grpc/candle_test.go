@@ -0,0 +1,115 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	// external packages
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCandleRange(t *testing.T) {
+	at := func(s string) *timestamppb.Timestamp {
+		tm, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return timestamppb.New(tm)
+	}
+
+	tests := []struct {
+		name       string
+		d          time.Duration
+		from, to   *timestamppb.Timestamp
+		begin, end string
+	}{
+		{"aligned", time.Hour, at("2023-09-01T10:00:00Z"), at("2023-09-01T12:00:00Z"), "2023-09-01T10:00:00Z", "2023-09-01T12:00:00Z"},
+		{"from rounds down", time.Hour, at("2023-09-01T10:30:00Z"), at("2023-09-01T12:00:00Z"), "2023-09-01T10:00:00Z", "2023-09-01T12:00:00Z"},
+		{"to rounds up", 5 * time.Minute, at("2023-09-01T10:00:00Z"), at("2023-09-01T10:07:00Z"), "2023-09-01T10:00:00Z", "2023-09-01T10:10:00Z"},
+		{"no from", time.Minute, nil, at("2023-09-01T10:00:30Z"), "0001-01-01T00:00:00Z", "2023-09-01T10:01:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			begin, end := candleRange(tt.d, tt.from, tt.to)
+			if got := begin.UTC().Format(time.RFC3339); got != tt.begin {
+				t.Fatalf("begin = %s, want %s", got, tt.begin)
+			}
+			if got := end.UTC().Format(time.RFC3339); got != tt.end {
+				t.Fatalf("end = %s, want %s", got, tt.end)
+			}
+		})
+	}
+
+	now := time.Now()
+	if _, end := candleRange(time.Minute, nil, nil); !end.After(now) || end.Sub(now) > time.Minute {
+		t.Fatalf("end = %s, want the end of the current minute", end)
+	}
+}
+
+func TestMergeCandle(t *testing.T) {
+	tests := []struct {
+		name  string
+		delta *Candle
+		want  *Candle
+	}{
+		{"higher", &Candle{High: 12, Low: 11, Close: 11.5, Volume: 3}, &Candle{Open: 10, High: 12, Low: 9, Close: 11.5, Volume: 13}},
+		{"lower", &Candle{High: 9.5, Low: 8, Close: 8.5, Volume: 1}, &Candle{Open: 10, High: 11, Low: 8, Close: 8.5, Volume: 11}},
+		{"inside", &Candle{High: 10.5, Low: 9.5, Close: 10, Volume: 0}, &Candle{Open: 10, High: 11, Low: 9, Close: 10, Volume: 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Candle{Open: 10, High: 11, Low: 9, Close: 10.5, Volume: 10}
+			mergeCandle(c, tt.delta)
+			if c.Open != tt.want.Open || c.High != tt.want.High || c.Low != tt.want.Low || c.Close != tt.want.Close || c.Volume != tt.want.Volume {
+				t.Fatalf("candle = %v, want %v", c, tt.want)
+			}
+		})
+	}
+}
+
+func TestCandleAggregatorPending(t *testing.T) {
+	start := time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+	quote := func(code string, offset time.Duration, last float64, volume int64) *Quote {
+		return &Quote{Code: code, Last: last, Volume: volume, Time: timestamppb.New(start.Add(offset))}
+	}
+
+	a := NewCandleAggregator(nil)
+	a.add([]*Quote{quote("AAA", 0, 10, 1), quote("BBB", 0, 20, 1)})
+	a.add([]*Quote{quote("AAA", 30*time.Second, 12, 2)})
+	a.add([]*Quote{quote("AAA", 90*time.Second, 9, 4)})
+
+	minute := a.pending[candleKey{code: "AAA", period: "1m", start: start.Unix()}]
+	if minute == nil || minute.Open != 10 || minute.High != 12 || minute.Low != 10 || minute.Close != 12 || minute.Volume != 3 {
+		t.Fatalf("1m candle = %v", minute)
+	}
+	hour := a.pending[candleKey{code: "AAA", period: "1h", start: start.Unix()}]
+	if hour == nil || hour.Open != 10 || hour.High != 12 || hour.Low != 9 || hour.Close != 9 || hour.Volume != 7 {
+		t.Fatalf("1h candle = %v", hour)
+	}
+	if !a.latest.Equal(start.Add(90 * time.Second)) {
+		t.Fatalf("latest = %s", a.latest)
+	}
+
+	from, to := timestamppb.New(start), timestamppb.New(start.Add(time.Minute))
+	cutoff, dropped := a.dropPending("AAA", []string{"1m"}, from, to)
+	if !cutoff.Equal(a.latest) || len(dropped) != 1 {
+		t.Fatalf("cutoff = %s, dropped = %v", cutoff, dropped)
+	}
+	if _, ok := a.pending[candleKey{code: "AAA", period: "1m", start: start.Unix()}]; ok {
+		t.Fatal("the rebuilt 1m candle is still pending")
+	}
+	if _, ok := a.pending[candleKey{code: "BBB", period: "1m", start: start.Unix()}]; !ok {
+		t.Fatal("the 1m candle of another stock was dropped")
+	}
+
+	// a tick during a failed rebuild is merged after the restored candle
+	a.add([]*Quote{quote("AAA", 50*time.Second, 13, 5)})
+	a.restorePending(dropped)
+
+	minute = a.pending[candleKey{code: "AAA", period: "1m", start: start.Unix()}]
+	if minute.Open != 10 || minute.High != 13 || minute.Close != 13 || minute.Volume != 8 {
+		t.Fatalf("restored 1m candle = %v", minute)
+	}
+}
@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	// external packages
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	forwardedForKey string = "x-forwarded-for"
+	clientAddrKey   string = "clientAddr"
+)
+
+// ClientAddrUnaryServerInterceptor resolves the address of the caller. A
+// call from one of the trusted proxies, like the web server that relays
+// every visitor, is attributed to the address it forwarded instead.
+func ClientAddrUnaryServerInterceptor(trusted []*net.IPNet) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(context.WithValue(ctx, clientAddrKey, resolveClientAddr(ctx, trusted)), req)
+	}
+}
+
+func ClientAddrStreamServerInterceptor(trusted []*net.IPNet) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		stream := grpc_middleware.WrapServerStream(ss)
+		stream.WrappedContext = context.WithValue(ss.Context(), clientAddrKey, resolveClientAddr(ss.Context(), trusted))
+		return handler(srv, stream)
+	}
+}
+
+// resolveClientAddr walks x-forwarded-for from the right while the hops are
+// trusted proxies, so a client can't choose its address by sending the
+// header itself: what it sends is left of the address its proxy appended.
+func resolveClientAddr(ctx context.Context, trusted []*net.IPNet) string {
+	addr := peerAddr(ctx)
+	if addr == "" || !trustedProxy(addr, trusted) {
+		return addr
+	}
+
+	var hops []string
+	for _, value := range metadata.ValueFromIncomingContext(ctx, forwardedForKey) {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		addr = hop
+		if !trustedProxy(hop, trusted) {
+			break
+		}
+	}
+	return addr
+}
+
+func peerAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return addr
+}
+
+func trustedProxy(addr string, trusted []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range trusted {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientAddrFromContext is the resolved address of the caller, or its peer
+// address when the interceptor did not run.
+func clientAddrFromContext(ctx context.Context) string {
+	if addr, ok := ctx.Value(clientAddrKey).(string); ok {
+		return addr
+	}
+	return peerAddr(ctx)
+}
+
+// ParseTrustedProxies reads a comma separated list of CIDRs or addresses.
+func ParseTrustedProxies(s string) ([]*net.IPNet, error) {
+	var trusted []*net.IPNet
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+		if !strings.Contains(v, "/") {
+			ip := net.ParseIP(v)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid address '%s'", v)
+			}
+			bits := 8 * len(ip.To16())
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			trusted = append(trusted, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, err
+		}
+		trusted = append(trusted, n)
+	}
+	return trusted, nil
+}
@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	// external packages
+	"google.golang.org/grpc/metadata"
+)
+
+func TestResolveClientAddr(t *testing.T) {
+	trusted, err := ParseTrustedProxies("10.0.0.0/8, 192.0.2.10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		peer         string
+		forwardedFor []string
+		want         string
+	}{
+		{"direct", "198.51.100.1:5000", nil, "198.51.100.1"},
+		{"untrusted peer can't forward", "198.51.100.1:5000", []string{"203.0.113.9"}, "198.51.100.1"},
+		{"trusted proxy", "10.0.0.2:5000", []string{"203.0.113.9"}, "203.0.113.9"},
+		{"trusted address", "192.0.2.10:5000", []string{"203.0.113.9"}, "203.0.113.9"},
+		{"proxy chain", "10.0.0.2:5000", []string{"203.0.113.9, 10.0.0.3"}, "203.0.113.9"},
+		{"spoofed hop left of the client", "10.0.0.2:5000", []string{"1.1.1.1, 203.0.113.9"}, "203.0.113.9"},
+		{"several headers", "10.0.0.2:5000", []string{"1.1.1.1", "203.0.113.9"}, "203.0.113.9"},
+		{"no header", "10.0.0.2:5000", nil, "10.0.0.2"},
+		{"garbage", "10.0.0.2:5000", []string{"unknown"}, "10.0.0.2"},
+		{"ipv6", "10.0.0.2:5000", []string{"2001:db8::1"}, "2001:db8::1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peerContext(tt.peer)
+			if tt.forwardedFor != nil {
+				md := metadata.MD{}
+				md.Append(forwardedForKey, tt.forwardedFor...)
+				ctx = metadata.NewIncomingContext(ctx, md)
+			}
+			if got := resolveClientAddr(ctx, trusted); got != tt.want {
+				t.Fatalf("resolveClientAddr = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	trusted, err := ParseTrustedProxies("10.0.0.0/8,192.0.2.10,2001:db8::1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for addr, want := range map[string]bool{"10.1.2.3": true, "192.0.2.10": true, "192.0.2.11": false, "2001:db8::1": true, "2001:db8::2": false} {
+		if got := trustedProxy(addr, trusted); got != want {
+			t.Fatalf("trustedProxy(%s) = %v, want %v", addr, got, want)
+		}
+	}
+
+	for _, s := range []string{"10.0.0.0/33", "example.com"} {
+		if _, err := ParseTrustedProxies(s); err == nil {
+			t.Fatalf("ParseTrustedProxies(%q) succeeded", s)
+		}
+	}
+	if trusted, err := ParseTrustedProxies(""); err != nil || len(trusted) != 0 {
+		t.Fatalf("ParseTrustedProxies(\"\") = %v, %v, want none", trusted, err)
+	}
+}
+
+// forwardedContext is a call of the visitor at addr relayed by the proxy.
+func forwardedContext(proxy string, addr string, trusted []*net.IPNet) context.Context {
+	ctx := metadata.NewIncomingContext(peerContext(proxy), metadata.Pairs(forwardedForKey, addr))
+	return context.WithValue(ctx, clientAddrKey, resolveClientAddr(ctx, trusted))
+}
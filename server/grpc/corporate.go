@@ -0,0 +1,394 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const corporateActionColumns = "a.id, s.code, a.type, a.old_shares, a.new_shares, a.shares, a.effective_at, a.applied_at, a.shares_before, a.shares_after"
+
+func (s *Stocks) CreateCorporateAction(ctx context.Context, newAction *NewCorporateAction) (*CorporateAction, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/CreateCorporateAction")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	if !principalFromContext(ctx).Moderator {
+		loggerFromContext(ctx).Errorf("CreateCorporateAction: permission denied")
+		return nil, status.Error(codes.PermissionDenied, "only a moderator can create a corporate action")
+	}
+
+	if err := validateCorporateAction(newAction, time.Now()); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CreateCorporateAction")
+		return nil, err
+	}
+
+	stock, err := selectStock(ctx, db, "code", strings.ToUpper(newAction.Code))
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CreateCorporateAction")
+		return nil, err
+	}
+
+	action, err := insertCorporateAction(ctx, db, newAction, stock)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CreateCorporateAction")
+		return nil, err
+	}
+
+	return action, nil
+}
+
+func (s *Stocks) ListCorporateActions(ctx context.Context, stockCode *StockCode) (*CorporateActionList, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/ListCorporateActions")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	list, err := selectCorporateActions(ctx, db, "s.code = $1", strings.ToUpper(stockCode.Code))
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ListCorporateActions")
+		return nil, err
+	}
+
+	return &CorporateActionList{
+		CorporateActionList: list,
+	}, nil
+}
+
+func (s *Stocks) GetShareCount(ctx context.Context, request *ShareCountRequest) (*ShareCount, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/GetShareCount")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	asOf := time.Now()
+	if request.AsOf != nil {
+		asOf = request.AsOf.AsTime()
+	}
+
+	stock, err := selectStock(ctx, db, "code", strings.ToUpper(request.Code))
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetShareCount")
+		return nil, err
+	}
+
+	count, err := selectShareCount(ctx, db, stock, asOf)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetShareCount")
+		return nil, err
+	}
+
+	return &ShareCount{
+		Code:            stock.Code,
+		AsOf:            timestamppb.New(asOf),
+		TotalStockCount: count,
+	}, nil
+}
+
+func (s *Stocks) runCorporateActions(db *sql.DB, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		actions, err := selectCorporateActions(context.Background(), db,
+			"a.applied_at IS NULL AND a.effective_at <= now()")
+		if err != nil {
+			sentry.CaptureException(err)
+			log.Errorf("CorporateActions: failed to select due actions. %s", err)
+			continue
+		}
+
+		for _, action := range actions {
+			if err := s.applyCorporateAction(db, action); err != nil {
+				sentry.CaptureException(err)
+				log.Errorf("CorporateActions: failed to apply action '%d'. %s", action.Id, err)
+			}
+		}
+	}
+}
+
+// applyCorporateAction updates the share count and, for splits, rescales
+// quotes and candles, rescales holdings and cancels the open orders of the
+// stock since their prices no longer apply. The cancellations are
+// published to the order stream of their accounts; orders and trades
+// already in the history are not adjusted.
+//
+// The feed is held from the candle flush until it is rescaled too. Every
+// quote and candle recorded until then is of the old scale, including the
+// ticks between the effective time and now, so all of them are rescaled
+// and no tick of the old scale can land in between.
+func (s *Stocks) applyCorporateAction(db *sql.DB, action *CorporateAction) error {
+	split := action.Type == CorporateActionType_SPLIT || action.Type == CorporateActionType_REVERSE_SPLIT
+
+	var book *orderBook
+	if split {
+		release := s.feed.hold()
+		defer release()
+
+		book = s.trading.book(action.Code)
+		book.mu.Lock()
+		defer book.mu.Unlock()
+
+		if err := s.candles.Flush(); err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var stockId, before int64
+	err = tx.QueryRow("SELECT id, total_stock_count FROM stocks WHERE code = $1 FOR UPDATE", action.Code).
+		Scan(&stockId, &before)
+	if err != nil {
+		return err
+	}
+
+	after := shareCountAfter(before, action)
+	if after < 0 || after > math.MaxInt32 {
+		return fmt.Errorf("share count of '%s' would become %d", action.Code, after)
+	}
+
+	if _, err := tx.Exec("UPDATE stocks SET total_stock_count = $1 WHERE id = $2", after, stockId); err != nil {
+		return err
+	}
+
+	if split {
+		if err := adjustForSplit(tx, stockId, action); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(
+		"UPDATE corporate_action SET applied_at = now(), shares_before = $1, shares_after = $2 WHERE id = $3",
+		before, after, action.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	if split {
+		ratio := splitRatio(action)
+		for id, r := range book.orders {
+			cancelled := proto.Clone(r.order).(*Order)
+			cancelled.Status = OrderStatus_CANCELLED
+			book.remove(id)
+			s.trading.orders.publish(cancelled)
+		}
+		if book.lastTrade != nil {
+			last := proto.Clone(book.lastTrade).(*Trade)
+			last.Price = roundPrice(last.Price / ratio)
+			last.Quantity = int64(math.Round(float64(last.Quantity) * ratio))
+			book.lastTrade = last
+		}
+		s.feed.adjust(action.Code, ratio)
+		s.trading.updates.publish(book.snapshot(maxBookDepth))
+	}
+
+	log.Infof("CorporateActions: applied %s of '%s', %d -> %d shares", action.Type, action.Code, before, after)
+	return nil
+}
+
+func adjustForSplit(tx *sql.Tx, stockId int64, action *CorporateAction) error {
+	ratio := splitRatio(action)
+
+	_, err := tx.Exec(`
+UPDATE quote
+   SET bid    = round((bid / $2)::numeric, 2),
+       ask    = round((ask / $2)::numeric, 2),
+       last   = round((last / $2)::numeric, 2),
+       volume = round(volume * $2)
+ WHERE stock_id = $1`,
+		stockId, ratio)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+UPDATE candle
+   SET open   = round((open / $2)::numeric, 2),
+       high   = round((high / $2)::numeric, 2),
+       low    = round((low / $2)::numeric, 2),
+       close  = round((close / $2)::numeric, 2),
+       volume = round(volume * $2)
+ WHERE stock_id = $1`,
+		stockId, ratio)
+	if err != nil {
+		return err
+	}
+
+	// fractional shares left by a reverse split are dropped, the cost basis stays the same
+	_, err = tx.Exec(
+		"UPDATE holding SET quantity = quantity * $2 / $3 WHERE stock_id = $1",
+		stockId, action.NewShares, action.OldShares)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+UPDATE orders
+   SET status = 'CANCELLED', updated_at = now()
+ WHERE stock_id = $1 AND status IN ('OPEN', 'PARTIALLY_FILLED')`,
+		stockId)
+	return err
+}
+
+func validateCorporateAction(action *NewCorporateAction, now time.Time) error {
+	if action.EffectiveAt == nil {
+		return status.Error(codes.InvalidArgument, "'effective_at' is required")
+	}
+	if action.EffectiveAt.AsTime().Before(now) {
+		return status.Error(codes.InvalidArgument, "'effective_at' must not be in the past")
+	}
+
+	switch action.Type {
+	case CorporateActionType_SPLIT:
+		if action.OldShares <= 0 || action.NewShares <= action.OldShares {
+			return status.Error(codes.InvalidArgument, "a split needs 0 < 'old_shares' < 'new_shares'")
+		}
+	case CorporateActionType_REVERSE_SPLIT:
+		if action.NewShares <= 0 || action.OldShares <= action.NewShares {
+			return status.Error(codes.InvalidArgument, "a reverse split needs 0 < 'new_shares' < 'old_shares'")
+		}
+	case CorporateActionType_ISSUANCE, CorporateActionType_BUYBACK:
+		if action.Shares <= 0 {
+			return status.Error(codes.InvalidArgument, "'shares' must be positive")
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid 'type' %s", action.Type)
+	}
+
+	return nil
+}
+
+func shareCountAfter(count int64, action *CorporateAction) int64 {
+	switch action.Type {
+	case CorporateActionType_SPLIT, CorporateActionType_REVERSE_SPLIT:
+		return count * action.NewShares / action.OldShares
+	case CorporateActionType_ISSUANCE:
+		return count + action.Shares
+	case CorporateActionType_BUYBACK:
+		return count - action.Shares
+	default:
+		return count
+	}
+}
+
+func splitRatio(action *CorporateAction) float64 {
+	return float64(action.NewShares) / float64(action.OldShares)
+}
+
+// selectShareCount answers from the snapshot taken when the first action
+// after asOf was applied, or projects pending actions for a future asOf.
+func selectShareCount(ctx context.Context, db *sql.DB, stock *Stock, asOf time.Time) (int64, error) {
+	var before int64
+	err := db.QueryRowContext(ctx, `
+SELECT shares_before
+  FROM corporate_action
+ WHERE stock_id = $1 AND applied_at IS NOT NULL AND effective_at > $2
+ ORDER BY effective_at, id
+ LIMIT 1`,
+		stock.Id, asOf).Scan(&before)
+	if err == nil {
+		return before, nil
+	}
+	if err != sql.ErrNoRows {
+		return 0, err
+	}
+
+	pending, err := selectCorporateActions(ctx, db,
+		"a.stock_id = $1 AND a.applied_at IS NULL AND a.effective_at <= $2", stock.Id, asOf)
+	if err != nil {
+		return 0, err
+	}
+
+	count := stock.TotalStockCount
+	for _, action := range pending {
+		count = shareCountAfter(count, action)
+	}
+	return count, nil
+}
+
+func selectCorporateActions(ctx context.Context, db *sql.DB, cond string, args ...interface{}) ([]*CorporateAction, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT `+corporateActionColumns+`
+  FROM corporate_action a
+  JOIN stocks s ON s.id = a.stock_id
+ WHERE `+cond+`
+ ORDER BY a.effective_at, a.id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*CorporateAction
+
+	for rows.Next() {
+		action, err := scanCorporateAction(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, action)
+	}
+
+	return list, rows.Err()
+}
+
+func insertCorporateAction(ctx context.Context, db *sql.DB, newAction *NewCorporateAction, stock *Stock) (*CorporateAction, error) {
+	row := db.QueryRowContext(ctx, `
+WITH a AS (
+    INSERT INTO corporate_action(stock_id, type, old_shares, new_shares, shares, effective_at)
+    VALUES ($1, $2, $3, $4, $5, $6)
+    RETURNING *
+)
+SELECT `+corporateActionColumns+`
+  FROM a
+  JOIN stocks s ON s.id = a.stock_id`,
+		stock.Id, newAction.Type.String(), newAction.OldShares, newAction.NewShares, newAction.Shares,
+		newAction.EffectiveAt.AsTime())
+
+	return scanCorporateAction(row)
+}
+
+func scanCorporateAction(row scanner) (*CorporateAction, error) {
+	var kind string
+	var effectiveAt time.Time
+	var appliedAt sql.NullTime
+	var before, after sql.NullInt64
+
+	action := &CorporateAction{}
+	err := row.Scan(&action.Id, &action.Code, &kind, &action.OldShares, &action.NewShares, &action.Shares,
+		&effectiveAt, &appliedAt, &before, &after)
+	if err != nil {
+		return nil, err
+	}
+
+	action.Type = CorporateActionType(CorporateActionType_value[kind])
+	action.EffectiveAt = timestamppb.New(effectiveAt)
+	action.AppliedAt = timestampOrNil(appliedAt)
+	action.SharesBefore = before.Int64
+	action.SharesAfter = after.Int64
+
+	return action, nil
+}
@@ -0,0 +1,254 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestShareCountAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		action *CorporateAction
+		want   int64
+	}{
+		{"split", &CorporateAction{Type: CorporateActionType_SPLIT, OldShares: 1, NewShares: 3}, 3000},
+		{"reverse split", &CorporateAction{Type: CorporateActionType_REVERSE_SPLIT, OldShares: 3, NewShares: 1}, 333},
+		{"issuance", &CorporateAction{Type: CorporateActionType_ISSUANCE, Shares: 250}, 1250},
+		{"buyback", &CorporateAction{Type: CorporateActionType_BUYBACK, Shares: 250}, 750},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shareCountAfter(1000, tt.action); got != tt.want {
+				t.Fatalf("shareCountAfter = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	reverse := &CorporateAction{Type: CorporateActionType_REVERSE_SPLIT, OldShares: 4, NewShares: 1}
+	if got := splitRatio(reverse); got != 0.25 {
+		t.Fatalf("splitRatio = %v, want 0.25", got)
+	}
+}
+
+func TestValidateCorporateAction(t *testing.T) {
+	now := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	later := timestamppb.New(now.Add(time.Hour))
+
+	tests := []struct {
+		name   string
+		action *NewCorporateAction
+		ok     bool
+	}{
+		{"split", &NewCorporateAction{Type: CorporateActionType_SPLIT, OldShares: 1, NewShares: 2, EffectiveAt: later}, true},
+		{"split that shrinks", &NewCorporateAction{Type: CorporateActionType_SPLIT, OldShares: 2, NewShares: 1, EffectiveAt: later}, false},
+		{"reverse split", &NewCorporateAction{Type: CorporateActionType_REVERSE_SPLIT, OldShares: 2, NewShares: 1, EffectiveAt: later}, true},
+		{"reverse split to nothing", &NewCorporateAction{Type: CorporateActionType_REVERSE_SPLIT, OldShares: 2, NewShares: 0, EffectiveAt: later}, false},
+		{"issuance", &NewCorporateAction{Type: CorporateActionType_ISSUANCE, Shares: 10, EffectiveAt: later}, true},
+		{"buyback of nothing", &NewCorporateAction{Type: CorporateActionType_BUYBACK, EffectiveAt: later}, false},
+		{"in the past", &NewCorporateAction{Type: CorporateActionType_ISSUANCE, Shares: 10, EffectiveAt: timestamppb.New(now.Add(-time.Hour))}, false},
+		{"no effective time", &NewCorporateAction{Type: CorporateActionType_ISSUANCE, Shares: 10}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCorporateAction(tt.action, now)
+			if tt.ok && err != nil {
+				t.Fatalf("validateCorporateAction = %v, want nil", err)
+			}
+			if !tt.ok && status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("validateCorporateAction = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestPriceFeedAdjust(t *testing.T) {
+	f := NewPriceFeed(nil, PriceFeedConfig{}, nil)
+	f.stocks = []*simStock{
+		{code: "AAA", last: &Quote{Code: "AAA", Bid: 99, Ask: 101, Last: 100}},
+		{code: "BBB", last: &Quote{Code: "BBB", Bid: 99, Ask: 101, Last: 100}},
+	}
+	before := f.Latest("AAA")
+
+	f.adjust("AAA", 4)
+
+	if q := f.Latest("AAA"); q.Bid != 24.75 || q.Ask != 25.25 || q.Last != 25 {
+		t.Fatalf("adjusted quote = %v, want 24.75/25.25/25", q)
+	}
+	if before.Last != 100 {
+		t.Fatal("adjust changed a quote already handed out")
+	}
+	if q := f.Latest("BBB"); q.Last != 100 {
+		t.Fatalf("another stock = %v, want it unchanged", q)
+	}
+}
+
+// insertTestStock creates a stock of count shares and removes it, with its
+// quotes, candles and actions, when the test ends.
+func insertTestStock(tb testing.TB, db *sql.DB, count int64) *Stock {
+	tb.Helper()
+
+	stock := &Stock{
+		Name:            fmt.Sprintf("%s %d", tb.Name(), time.Now().UnixNano()),
+		TotalStockCount: count,
+	}
+	for {
+		// codes are three letters, so draw again when one is taken
+		stock.Code = fmt.Sprintf("Z%c%c", 'A'+rand.Intn(26), 'A'+rand.Intn(26))
+		err := db.QueryRow("INSERT INTO stocks(code, name, total_stock_count) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING RETURNING id",
+			stock.Code, stock.Name, stock.TotalStockCount).Scan(&stock.Id)
+		if err == nil {
+			break
+		}
+		if err != sql.ErrNoRows {
+			tb.Fatal(err)
+		}
+	}
+
+	tb.Cleanup(func() {
+		db.Exec("DELETE FROM stocks WHERE id = $1", stock.Id)
+	})
+
+	return stock
+}
+
+func insertTestCorporateAction(tb testing.TB, db *sql.DB, stock *Stock, action *NewCorporateAction) *CorporateAction {
+	tb.Helper()
+
+	a, err := insertCorporateAction(context.Background(), db, action, stock)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return a
+}
+
+func TestGetShareCountAsOf(t *testing.T) {
+	db := openTestDB(t)
+	stock := insertTestStock(t, db, 1000)
+	s := &Stocks{}
+
+	now := time.Now()
+	issuance := insertTestCorporateAction(t, db, stock, &NewCorporateAction{
+		Type: CorporateActionType_ISSUANCE, Shares: 500, EffectiveAt: timestamppb.New(now.Add(-2 * time.Hour)),
+	})
+	if err := s.applyCorporateAction(db, issuance); err != nil {
+		t.Fatal(err)
+	}
+	insertTestCorporateAction(t, db, stock, &NewCorporateAction{
+		Type: CorporateActionType_BUYBACK, Shares: 200, EffectiveAt: timestamppb.New(now.Add(2 * time.Hour)),
+	})
+
+	tx := sentry.StartTransaction(context.Background(), "test")
+	defer tx.Finish()
+	ctx := context.WithValue(tx.Context(), DBSession, db)
+
+	tests := []struct {
+		name string
+		asOf time.Time
+		want int64
+	}{
+		{"before the issuance", now.Add(-3 * time.Hour), 1000},
+		{"after the issuance", now.Add(-time.Hour), 1500},
+		{"after the pending buyback", now.Add(3 * time.Hour), 1300},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, err := s.GetShareCount(ctx, &ShareCountRequest{Code: stock.Code, AsOf: timestamppb.New(tt.asOf)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count.TotalStockCount != tt.want {
+				t.Fatalf("share count = %d, want %d", count.TotalStockCount, tt.want)
+			}
+		})
+	}
+}
+
+// TestApplySplitHoldsFeed applies a split while the feed keeps ticking. The
+// feed is flat, so every quote and candle of the stock must end up at the
+// new scale, however the ticks interleave with the apply.
+func TestApplySplitHoldsFeed(t *testing.T) {
+	db := openTestDB(t)
+	stock := insertTestStock(t, db, 1000)
+
+	feed := NewPriceFeed(db, PriceFeedConfig{Interval: time.Second}, nil)
+	candles := NewCandleAggregator(db)
+	feed.Listen(candles.add)
+	trading, err := NewTrading(db, feed, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Stocks{feed: feed, candles: candles, trading: trading}
+
+	feed.runTick(time.Now())
+	price := feed.Latest(stock.Code).Last
+	action := insertTestCorporateAction(t, db, stock, &NewCorporateAction{
+		Type: CorporateActionType_SPLIT, OldShares: 1, NewShares: 2, EffectiveAt: timestamppb.New(time.Now().Add(-time.Minute)),
+	})
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				feed.runTick(time.Now())
+			}
+		}
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	if err := s.applyCorporateAction(db, action); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(stop)
+	<-done
+
+	if err := candles.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := price / 2
+	if last := feed.Latest(stock.Code).Last; last < want-0.01 || last > want+0.01 {
+		t.Fatalf("feed price = %v, want %v", last, want)
+	}
+
+	var quotes, candleRows int
+	err = db.QueryRow("SELECT count(*) FROM quote WHERE stock_id = $1 AND abs(last - $2) > 0.011",
+		stock.Id, want).Scan(&quotes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.QueryRow("SELECT count(*) FROM candle WHERE stock_id = $1 AND (abs(high - $2) > 0.011 OR abs(low - $2) > 0.011)",
+		stock.Id, want).Scan(&candleRows)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quotes != 0 || candleRows != 0 {
+		t.Fatalf("%d quotes and %d candles are not at the new scale", quotes, candleRows)
+	}
+
+	var count int64
+	if err := db.QueryRow("SELECT total_stock_count FROM stocks WHERE id = $1", stock.Id).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 2000 {
+		t.Fatalf("share count = %d, want 2000", count)
+	}
+}
@@ -0,0 +1,21 @@
+package grpc
+
+import (
+	"database/sql"
+)
+
+// inTx runs fn in a transaction, rolls it back when fn fails and returns
+// the commit error otherwise.
+func inTx(db *sql.DB, fn func(tx *sql.Tx) error) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
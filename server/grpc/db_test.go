@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+// openTestDB connects to the database of TEST_DATABASE_DSN, a disposable
+// database with the schema of .local/conf/postgres/init applied. The audit
+// log is append-only, so the entries written by tests stay there. Tests
+// that need it are skipped when it is not set.
+func openTestDB(tb testing.TB) *sql.DB {
+	tb.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		tb.Skip("TEST_DATABASE_DSN is not set")
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		tb.Fatal(err)
+	}
+	db.SetMaxOpenConns(20)
+	tb.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+// insertTestQuestion creates an open subject with one question of likes and
+// removes both when the test ends.
+func insertTestQuestion(tb testing.TB, db *sql.DB, likes int64) (int64, int64) {
+	tb.Helper()
+
+	var subjectId, questionId int64
+	title := fmt.Sprintf("%s %d", tb.Name(), time.Now().UnixNano())
+	if err := db.QueryRow("INSERT INTO subject(title) VALUES ($1) RETURNING id", title).Scan(&subjectId); err != nil {
+		tb.Fatal(err)
+	}
+	err := db.QueryRow("INSERT INTO question(question, subject_id, likes) VALUES ($1, $2, $3) RETURNING id",
+		title, subjectId, likes).Scan(&questionId)
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	tb.Cleanup(func() {
+		db.Exec("DELETE FROM question WHERE id = $1", questionId)
+		db.Exec("DELETE FROM subject WHERE id = $1", subjectId)
+	})
+
+	return subjectId, questionId
+}
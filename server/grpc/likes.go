@@ -0,0 +1,368 @@
+package grpc
+
+import (
+	"database/sql"
+	"sort"
+	"sync"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errLikeBufferClosed = status.Error(codes.Unavailable, "likes are not accepted while the server shuts down")
+	errLikeBufferFull   = status.Error(codes.Unavailable, "likes are not accepted for now, try again later")
+)
+
+// LikeBuffer coalesces likes per question in memory and writes them in one
+// batched UPDATE, either every interval or once threshold calls are
+// pending. Readers merge the pending deltas, and Close refuses new likes
+// and flushes whatever is left so a shutdown loses no likes. The audit
+// entries of the calls are written by the flush that applies them.
+//
+// At most capacity calls are held, counting a batch that is being flushed,
+// so an unreachable database can't grow the buffer or the retried batch
+// without bound. Calls past it are refused and counted as dropped.
+type LikeBuffer struct {
+	db        *sql.DB
+	threshold int
+	capacity  int
+
+	flushMu sync.Mutex
+
+	mu       sync.Mutex
+	pending  map[int64]int64
+	flushing map[int64]int64
+	likes    []bufferedLike
+	inFlight int
+	dropped  int
+	closed   bool
+
+	full chan struct{}
+	stop chan struct{}
+	done chan struct{}
+
+	listeners []func(subjectIds []int64, clients []string)
+}
+
+// bufferedLike is one Like or Unlike call of client, audited once a flush
+// applies it.
+type bufferedLike struct {
+	questionId int64
+	delta      int64
+	client     string
+	audit      auditEntry
+}
+
+func NewLikeBuffer(db *sql.DB, threshold, capacity int) *LikeBuffer {
+	return &LikeBuffer{
+		db:        db,
+		threshold: threshold,
+		capacity:  capacity,
+		pending:   make(map[int64]int64),
+		full:      make(chan struct{}, 1),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+func (l *LikeBuffer) Run(interval time.Duration) {
+	defer close(l.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+		case <-l.full:
+		}
+
+		if err := l.Flush(); err != nil {
+			sentry.CaptureException(err)
+			log.Errorf("LikeBuffer: failed to flush likes. %s", err)
+		}
+	}
+}
+
+// Listen registers fn to be called with the subjects whose likes were
+// written by a flush and the clients that made the flushed calls. It must
+// be called before Run.
+func (l *LikeBuffer) Listen(fn func(subjectIds []int64, clients []string)) {
+	l.listeners = append(l.listeners, fn)
+}
+
+// Close refuses further likes, stops Run and flushes the pending likes. It
+// must be called at most once, after Run was started.
+func (l *LikeBuffer) Close() error {
+	l.mu.Lock()
+	l.closed = true
+	l.mu.Unlock()
+
+	close(l.stop)
+	<-l.done
+	return l.Flush()
+}
+
+func (l *LikeBuffer) add(questionId int64, delta int64, client string, audit auditEntry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return errLikeBufferClosed
+	}
+	if l.fullLocked() {
+		return errLikeBufferFull
+	}
+	l.addLocked(questionId, delta, client, audit)
+	return nil
+}
+
+// sub takes one like back unless stored plus pending likes would drop
+// below zero.
+func (l *LikeBuffer) sub(questionId int64, stored int64, client string, audit auditEntry) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return false, errLikeBufferClosed
+	}
+	if l.fullLocked() {
+		return false, errLikeBufferFull
+	}
+	if stored+l.pendingOf(questionId) <= 0 {
+		return false, nil
+	}
+	l.addLocked(questionId, -1, client, audit)
+	return true, nil
+}
+
+func (l *LikeBuffer) fullLocked() bool {
+	if l.capacity <= 0 || len(l.likes)+l.inFlight < l.capacity {
+		return false
+	}
+	l.dropped++
+	return true
+}
+
+func (l *LikeBuffer) addLocked(questionId int64, delta int64, client string, audit auditEntry) {
+	l.likes = append(l.likes, bufferedLike{questionId: questionId, delta: delta, client: client, audit: audit})
+	l.pending[questionId] += delta
+	if l.pending[questionId] == 0 {
+		delete(l.pending, questionId)
+	}
+
+	if l.threshold > 0 && len(l.likes) >= l.threshold {
+		select {
+		case l.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// merge adds the pending likes to questions and keeps the ListQuestions
+// order of likes descending, then question text.
+func (l *LikeBuffer) merge(questions []*Question) {
+	l.mu.Lock()
+	changed := false
+	for _, q := range questions {
+		if d := l.pendingOf(q.Id); d != 0 {
+			q.LikesCount += d
+			if q.LikesCount < 0 {
+				q.LikesCount = 0
+			}
+			changed = true
+		}
+	}
+	l.mu.Unlock()
+
+	if changed {
+		sort.SliceStable(questions, func(i, j int) bool {
+			if questions[i].LikesCount != questions[j].LikesCount {
+				return questions[i].LikesCount > questions[j].LikesCount
+			}
+			return questions[i].Question < questions[j].Question
+		})
+	}
+}
+
+// pendingOf counts both the buffered likes and the ones being flushed, so
+// readers never miss likes that are on their way to the database.
+func (l *LikeBuffer) pendingOf(questionId int64) int64 {
+	return l.pending[questionId] + l.flushing[questionId]
+}
+
+func (l *LikeBuffer) Flush() error {
+	l.flushMu.Lock()
+	defer l.flushMu.Unlock()
+
+	l.mu.Lock()
+	pending, likes, dropped := l.pending, l.likes, l.dropped
+	l.pending = make(map[int64]int64)
+	l.likes = nil
+	l.inFlight = len(likes)
+	l.dropped = 0
+	l.flushing = pending
+	l.mu.Unlock()
+
+	if dropped > 0 {
+		log.Warnf("LikeBuffer: dropped %d likes while %d were buffered", dropped, l.capacity)
+	}
+	if len(likes) == 0 {
+		return nil
+	}
+
+	subjectIds, err := flushQuestionLikes(l.db, likes)
+
+	l.mu.Lock()
+	l.flushing = nil
+	l.inFlight = 0
+	if err != nil {
+		for id, d := range pending {
+			l.pending[id] += d
+		}
+		l.likes = append(likes, l.likes...)
+	}
+	l.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+	var clients []string
+	seen := make(map[string]bool)
+	for _, like := range likes {
+		if like.client != "" && !seen[like.client] {
+			seen[like.client] = true
+			clients = append(clients, like.client)
+		}
+	}
+	for _, fn := range l.listeners {
+		fn(subjectIds, clients)
+	}
+	return nil
+}
+
+// flushQuestionLikes locks the questions in id order, so concurrent flushes
+// lock rows in the same order, replays the calls on their counts and
+// writes the results. Likes of questions that were deleted or whose
+// subject was closed or deleted in the meantime are dropped without an
+// audit entry. It returns the subjects of the updated questions.
+func flushQuestionLikes(db *sql.DB, likes []bufferedLike) ([]int64, error) {
+	ids := make([]int64, 0, len(likes))
+	seen := make(map[int64]bool)
+	for _, like := range likes {
+		if !seen[like.questionId] {
+			seen[like.questionId] = true
+			ids = append(ids, like.questionId)
+		}
+	}
+
+	var subjectIds []int64
+
+	err := inTx(db, func(tx *sql.Tx) error {
+		rows, err := tx.Query(`
+SELECT q.id, q.subject_id, q.likes
+  FROM question q
+  JOIN subject s ON s.id = q.subject_id
+ WHERE q.id = ANY($1)
+   AND q.deleted_at IS NULL
+   AND s.deleted_at IS NULL
+   AND s.closed_at IS NULL
+ ORDER BY q.id
+   FOR UPDATE OF q`, pq.Array(ids))
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		counts := make(map[int64]int64)
+		subjects := make(map[int64]int64)
+		for rows.Next() {
+			var id, subjectId, count int64
+			if err := rows.Scan(&id, &subjectId, &count); err != nil {
+				return err
+			}
+			counts[id] = count
+			subjects[id] = subjectId
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		stored := make(map[int64]int64, len(counts))
+		for id, count := range counts {
+			stored[id] = count
+		}
+		audits := replayLikes(counts, likes)
+
+		var updated, updatedLikes []int64
+		changed := make(map[int64]bool)
+		for id, count := range counts {
+			if count == stored[id] {
+				continue
+			}
+			updated = append(updated, id)
+			updatedLikes = append(updatedLikes, count)
+			if !changed[subjects[id]] {
+				changed[subjects[id]] = true
+				subjectIds = append(subjectIds, subjects[id])
+			}
+		}
+
+		if len(updated) != 0 {
+			_, err = tx.Exec(`
+UPDATE question q
+   SET likes = d.likes, updated_at = now()
+  FROM unnest($1::bigint[], $2::bigint[]) AS d(id, likes)
+ WHERE q.id = d.id`,
+				pq.Array(updated), pq.Array(updatedLikes))
+			if err != nil {
+				return err
+			}
+		}
+
+		return insertAudit(tx, audits...)
+	})
+
+	return subjectIds, err
+}
+
+// replayLikes applies the calls in order to counts, the stored likes of the
+// questions that still take likes, and returns their audit entries with
+// the count before and after each call. Likes are clamped at zero since an
+// unlike is checked against a count read before the flush.
+func replayLikes(counts map[int64]int64, likes []bufferedLike) []auditEntry {
+	var audits []auditEntry
+
+	for _, like := range likes {
+		before, ok := counts[like.questionId]
+		if !ok {
+			continue
+		}
+		after := before + like.delta
+		if after < 0 {
+			after = 0
+		}
+		counts[like.questionId] = after
+
+		audit := like.audit
+		audit.before = &Question{Id: like.questionId, LikesCount: before}
+		audit.after = &Question{Id: like.questionId, LikesCount: after}
+		audits = append(audits, audit)
+	}
+
+	return audits
+}
+
+func selectQuestionLikes(stmts *boardStatements, questionId int64) (int64, error) {
+	var likes int64
+	err := stmts.selectQuestionLikes.QueryRow(questionId).Scan(&likes)
+	return likes, err
+}
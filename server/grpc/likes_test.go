@@ -0,0 +1,253 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLikeBufferSub(t *testing.T) {
+	l := NewLikeBuffer(nil, 0, 0)
+
+	if err := l.add(1, 1, "", auditEntry{}); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := l.sub(1, 0, "", auditEntry{}); !ok || err != nil {
+		t.Fatalf("sub = %v, %v, want the pending like taken back", ok, err)
+	}
+	if ok, err := l.sub(1, 0, "", auditEntry{}); ok || err != nil {
+		t.Fatalf("sub = %v, %v, want no like below zero", ok, err)
+	}
+	if ok, err := l.sub(1, 2, "", auditEntry{}); !ok || err != nil {
+		t.Fatalf("sub = %v, %v, want a stored like taken back", ok, err)
+	}
+	if len(l.likes) != 3 {
+		t.Fatalf("%d buffered calls, want one per applied call", len(l.likes))
+	}
+}
+
+func TestLikeBufferClosed(t *testing.T) {
+	l := NewLikeBuffer(nil, 0, 0)
+	go l.Run(time.Hour)
+
+	if err := l.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.add(1, 1, "", auditEntry{}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("add after Close = %v, want Unavailable", err)
+	}
+	if _, err := l.sub(1, 5, "", auditEntry{}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("sub after Close = %v, want Unavailable", err)
+	}
+}
+
+func TestLikeBufferCapacity(t *testing.T) {
+	l := NewLikeBuffer(nil, 0, 3)
+	// one call is still being flushed
+	l.inFlight = 1
+
+	for i := 0; i < 2; i++ {
+		if err := l.add(1, 1, "", auditEntry{}); err != nil {
+			t.Fatalf("add %d = %v, want it buffered", i, err)
+		}
+	}
+	if err := l.add(1, 1, "", auditEntry{}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("add past the capacity = %v, want Unavailable", err)
+	}
+	if _, err := l.sub(1, 5, "", auditEntry{}); status.Code(err) != codes.Unavailable {
+		t.Fatalf("sub past the capacity = %v, want Unavailable", err)
+	}
+	if len(l.likes) != 2 || l.dropped != 2 {
+		t.Fatalf("%d buffered and %d dropped, want 2 and 2", len(l.likes), l.dropped)
+	}
+
+	l.inFlight = 0
+	if err := l.add(1, 1, "", auditEntry{}); err != nil {
+		t.Fatalf("add after the flush = %v, want it buffered", err)
+	}
+}
+
+func TestLikeBufferMerge(t *testing.T) {
+	l := NewLikeBuffer(nil, 0, 0)
+	l.add(2, 3, "", auditEntry{})
+	l.add(3, -5, "", auditEntry{})
+	l.flushing = map[int64]int64{1: 1}
+
+	list := []*Question{
+		{Id: 1, Question: "b", LikesCount: 4},
+		{Id: 2, Question: "c", LikesCount: 2},
+		{Id: 3, Question: "a", LikesCount: 1},
+		{Id: 4, Question: "a", LikesCount: 5},
+	}
+	l.merge(list)
+
+	want := []struct {
+		id    int64
+		likes int64
+	}{{4, 5}, {1, 5}, {2, 5}, {3, 0}}
+	for i, w := range want {
+		if list[i].Id != w.id || list[i].LikesCount != w.likes {
+			t.Fatalf("list[%d] = question %d with %d likes, want question %d with %d", i, list[i].Id, list[i].LikesCount, w.id, w.likes)
+		}
+	}
+}
+
+func TestReplayLikes(t *testing.T) {
+	like := func(questionId, delta int64) bufferedLike {
+		return bufferedLike{questionId: questionId, delta: delta, audit: auditEntry{targetId: questionId}}
+	}
+
+	// question 3 was deleted before the flush
+	counts := map[int64]int64{1: 1, 2: 5}
+	likes := []bufferedLike{like(1, -1), like(2, 1), like(1, -1), like(3, 1), like(1, 1)}
+
+	audits := replayLikes(counts, likes)
+
+	want := []struct {
+		id            int64
+		before, after int64
+	}{{1, 1, 0}, {2, 5, 6}, {1, 0, 0}, {1, 0, 1}}
+	if len(audits) != len(want) {
+		t.Fatalf("%d audit entries, want %d", len(audits), len(want))
+	}
+	for i, w := range want {
+		before := audits[i].before.(*Question)
+		after := audits[i].after.(*Question)
+		if audits[i].targetId != w.id || before.LikesCount != w.before || after.LikesCount != w.after {
+			t.Fatalf("audit %d = question %d from %d to %d, want question %d from %d to %d",
+				i, audits[i].targetId, before.LikesCount, after.LikesCount, w.id, w.before, w.after)
+		}
+	}
+	if counts[1] != 1 || counts[2] != 6 {
+		t.Fatalf("counts = %v, want the last count of each question", counts)
+	}
+	if _, ok := counts[3]; ok {
+		t.Fatal("a question without a stored count was replayed")
+	}
+}
+
+func TestFlushQuestionLikes(t *testing.T) {
+	db := openTestDB(t)
+	subjectId, questionId := insertTestQuestion(t, db, 1)
+	_, deletedId := insertTestQuestion(t, db, 0)
+	if _, err := db.Exec("UPDATE question SET deleted_at = now() WHERE id = $1", deletedId); err != nil {
+		t.Fatal(err)
+	}
+
+	requestId := t.Name() + time.Now().String()
+	like := func(questionId, delta int64) bufferedLike {
+		return bufferedLike{questionId: questionId, delta: delta, audit: auditEntry{
+			targetType: "question", targetId: questionId, requestId: requestId, createdAt: time.Now(),
+		}}
+	}
+
+	subjectIds, err := flushQuestionLikes(db, []bufferedLike{like(questionId, -1), like(questionId, -1), like(deletedId, 1), like(questionId, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subjectIds) != 0 {
+		t.Fatalf("subjects = %v, want none for an unchanged count", subjectIds)
+	}
+
+	subjectIds, err = flushQuestionLikes(db, []bufferedLike{like(questionId, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subjectIds) != 1 || subjectIds[0] != subjectId {
+		t.Fatalf("subjects = %v, want [%d]", subjectIds, subjectId)
+	}
+
+	var likes int64
+	if err := db.QueryRow("SELECT likes FROM question WHERE id = $1", questionId).Scan(&likes); err != nil {
+		t.Fatal(err)
+	}
+	if likes != 2 {
+		t.Fatalf("likes = %d, want 2", likes)
+	}
+
+	rows, err := db.Query(`
+SELECT target_id, (before->>'likes_count')::bigint, (after->>'likes_count')::bigint
+  FROM audit_log
+ WHERE request_id = $1
+ ORDER BY id`, requestId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	want := [][3]int64{{questionId, 1, 0}, {questionId, 0, 0}, {questionId, 0, 1}, {questionId, 1, 2}}
+	var got [][3]int64
+	for rows.Next() {
+		var entry [3]int64
+		var before, after sql.NullInt64
+		if err := rows.Scan(&entry[0], &before, &after); err != nil {
+			t.Fatal(err)
+		}
+		entry[1], entry[2] = before.Int64, after.Int64
+		got = append(got, entry)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("audit log = %v, want %v", got, want)
+	}
+}
+
+// BenchmarkLike compares writing every like to the database with
+// buffering them for one batched UPDATE per flush.
+func BenchmarkLike(b *testing.B) {
+	db := openTestDB(b)
+
+	stmts, err := prepareBoardStatements(db, false)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer stmts.Close()
+
+	tx := sentry.StartTransaction(context.Background(), "bench")
+	defer tx.Finish()
+
+	b.Run("direct", func(b *testing.B) {
+		_, questionId := insertTestQuestion(b, db, 0)
+		board := &Board{stmts: stmts}
+
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := board.Like(tx.Context(), &QuestionId{Id: questionId}); err != nil {
+					b.Error(err)
+					return
+				}
+			}
+		})
+	})
+
+	b.Run("buffered", func(b *testing.B) {
+		_, questionId := insertTestQuestion(b, db, 0)
+		likes := NewLikeBuffer(db, 1000, 0)
+		go likes.Run(time.Second)
+		board := &Board{stmts: stmts, likes: likes}
+
+		b.ResetTimer()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				if _, err := board.Like(tx.Context(), &QuestionId{Id: questionId}); err != nil {
+					b.Error(err)
+					return
+				}
+			}
+		})
+		// the flush is part of the cost of buffering
+		if err := likes.Close(); err != nil {
+			b.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const loggerKey string = "logger"
+
+type requestLogger struct {
+	entry *log.Entry
+	start time.Time
+}
+
+func LogUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = withRequestLogger(ctx, info.FullMethod)
+
+		resp, err := handler(ctx, req)
+		logAccess(ctx, err)
+
+		return resp, err
+	}
+}
+
+func LogStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		stream := grpc_middleware.WrapServerStream(ss)
+		stream.WrappedContext = withRequestLogger(ss.Context(), info.FullMethod)
+
+		err := handler(srv, stream)
+		logAccess(stream.WrappedContext, err)
+
+		return err
+	}
+}
+
+// withRequestLogger stores a logger carrying the fields of the call, so
+// every line logged while serving it can be correlated. It runs before the
+// call is authenticated, so a rejected call is still logged; the principal
+// is added by logPrincipal once it is known.
+func withRequestLogger(ctx context.Context, method string) context.Context {
+	fields := log.Fields{
+		"request_id": requestIdFromContext(ctx),
+		"method":     method,
+	}
+	if tx := sentry.TransactionFromContext(ctx); tx != nil {
+		fields["trace_id"] = tx.TraceID.String()
+	}
+
+	return context.WithValue(ctx, loggerKey, &requestLogger{
+		entry: log.WithFields(fields),
+		start: time.Now(),
+	})
+}
+
+// logPrincipal adds the principal to the logger of the call, including the
+// access line written after the handler returns.
+func logPrincipal(ctx context.Context, p *Principal) {
+	l, ok := ctx.Value(loggerKey).(*requestLogger)
+	if !ok {
+		return
+	}
+
+	principal := "anonymous"
+	if !p.Anonymous() {
+		principal = p.UserId
+	}
+	l.entry = l.entry.WithField("principal", principal)
+}
+
+// loggerFromContext returns the logger of the call with its latency so far,
+// or the standard logger outside of a call.
+func loggerFromContext(ctx context.Context) *log.Entry {
+	l, ok := ctx.Value(loggerKey).(*requestLogger)
+	if !ok {
+		return log.NewEntry(log.StandardLogger())
+	}
+	return l.entry.WithField("latency_ms", time.Since(l.start).Milliseconds())
+}
+
+func logAccess(ctx context.Context, err error) {
+	code := status.Code(err)
+	entry := loggerFromContext(ctx).WithField("code", code.String())
+
+	switch code {
+	case codes.OK, codes.Canceled, codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
+		codes.PermissionDenied, codes.ResourceExhausted, codes.FailedPrecondition, codes.OutOfRange,
+		codes.Unauthenticated:
+		entry.Info("gRPC access")
+	default:
+		entry.WithError(err).Error("gRPC access")
+	}
+}
@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	// external packages
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	log "github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLogUnaryServerInterceptor(t *testing.T) {
+	const method = "/board.Board/Like"
+	secret := []byte("secret")
+	alice, _ := SignToken(secret, "alice", time.Hour)
+
+	interceptor := grpc_middleware.ChainUnaryServer(
+		RequestIdUnaryServerInterceptor(),
+		LogUnaryServerInterceptor(),
+		AuthUnaryServerInterceptor(secret, nil),
+	)
+
+	tests := []struct {
+		name      string
+		token     string
+		err       error
+		principal interface{}
+		code      string
+		level     log.Level
+	}{
+		{"anonymous", "", nil, "anonymous", "OK", log.InfoLevel},
+		{"signed in", "Bearer " + alice, nil, "alice", "OK", log.InfoLevel},
+		{"bad token", "Bearer forged", nil, nil, "Unauthenticated", log.InfoLevel},
+		{"failed", "Bearer " + alice, status.Error(codes.Internal, "boom"), "alice", "Internal", log.ErrorLevel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := test.NewGlobal()
+			defer hook.Reset()
+
+			md := metadata.Pairs(requestIdKey, "req-1")
+			if tt.token != "" {
+				md.Set(authorizationKey, tt.token)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return nil, tt.err
+				})
+
+			entry := hook.LastEntry()
+			if entry == nil || entry.Message != "gRPC access" {
+				t.Fatalf("last entry = %v, want the access line", entry)
+			}
+			if entry.Level != tt.level {
+				t.Fatalf("level = %v, want %v", entry.Level, tt.level)
+			}
+
+			want := log.Fields{"request_id": "req-1", "method": method, "code": tt.code}
+			for k, v := range want {
+				if entry.Data[k] != v {
+					t.Fatalf("%s = %v, want %v", k, entry.Data[k], v)
+				}
+			}
+			if entry.Data["principal"] != tt.principal {
+				t.Fatalf("principal = %v, want %v", entry.Data["principal"], tt.principal)
+			}
+			if _, ok := entry.Data["latency_ms"].(int64); !ok {
+				t.Fatalf("latency_ms = %v, want the latency", entry.Data["latency_ms"])
+			}
+		})
+	}
+}
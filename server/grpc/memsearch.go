@@ -0,0 +1,197 @@
+package grpc
+
+import (
+	"context"
+	"html"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// MemoryQuestionSearcher is the QuestionSearcher of questions held in
+// memory, so the search can be exercised without Postgres. Like the 'simple'
+// text search configuration, it splits text into lowercase words without
+// stemming or stop words. Every word of the query must match, except the
+// words prefixed with '-', which must not.
+type MemoryQuestionSearcher struct {
+	mu        sync.RWMutex
+	questions map[int64]*memoryQuestion
+}
+
+type memoryQuestion struct {
+	subjectId int64
+	question  *Question
+	tokens    []searchToken
+}
+
+// searchToken is a lowercase word of a text and its byte range there.
+type searchToken struct {
+	word       string
+	start, end int
+}
+
+func NewMemoryQuestionSearcher() *MemoryQuestionSearcher {
+	return &MemoryQuestionSearcher{
+		questions: make(map[int64]*memoryQuestion),
+	}
+}
+
+// Put adds question to subjectId, or replaces the question of the same id.
+func (s *MemoryQuestionSearcher) Put(subjectId int64, question *Question) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.questions[question.Id] = &memoryQuestion{
+		subjectId: subjectId,
+		question:  question,
+		tokens:    tokenize(question.Question),
+	}
+}
+
+func (s *MemoryQuestionSearcher) Delete(id int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.questions, id)
+}
+
+func (s *MemoryQuestionSearcher) Search(ctx context.Context, query *QuestionQuery) ([]*QuestionMatch, error) {
+	include, exclude := parseSearchQuery(query.Query)
+	if len(include) == 0 {
+		return nil, nil
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var list []*QuestionMatch
+
+	for _, q := range s.questions {
+		if query.SubjectId != 0 && q.subjectId != query.SubjectId {
+			continue
+		}
+		if query.CreatedFrom != nil && q.question.CreatedAt.AsTime().Before(query.CreatedFrom.AsTime()) {
+			continue
+		}
+		if query.CreatedTo != nil && !q.question.CreatedAt.AsTime().Before(query.CreatedTo.AsTime()) {
+			continue
+		}
+		if query.Answered != nil && q.question.Answered != query.GetAnswered() {
+			continue
+		}
+
+		rank, ok := matchTokens(q.tokens, include, exclude)
+		if !ok {
+			continue
+		}
+
+		list = append(list, &QuestionMatch{
+			Question: q.question,
+			Rank:     rank,
+			Snippet:  highlight(q.question.Question, q.tokens, include),
+		})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if a.Rank != b.Rank {
+			return a.Rank > b.Rank
+		}
+		if a.Question.LikesCount != b.Question.LikesCount {
+			return a.Question.LikesCount > b.Question.LikesCount
+		}
+		return a.Question.Id < b.Question.Id
+	})
+
+	if limit := searchLimit(query); len(list) > limit {
+		list = list[:limit]
+	}
+
+	return list, nil
+}
+
+func tokenize(text string) []searchToken {
+	var tokens []searchToken
+
+	start := -1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = append(tokens, searchToken{strings.ToLower(text[start:i]), start, i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, searchToken{strings.ToLower(text[start:]), start, len(text)})
+	}
+
+	return tokens
+}
+
+func parseSearchQuery(query string) (include, exclude []string) {
+	for _, field := range strings.Fields(query) {
+		negated := strings.HasPrefix(field, "-")
+		for _, t := range tokenize(field) {
+			if negated {
+				exclude = append(exclude, t.word)
+			} else {
+				include = append(include, t.word)
+			}
+		}
+	}
+	return include, exclude
+}
+
+// matchTokens ranks a text by the share of its words that are query words,
+// so short texts about the query come before long texts mentioning it.
+func matchTokens(tokens []searchToken, include, exclude []string) (float32, bool) {
+	counts := make(map[string]int, len(tokens))
+	for _, t := range tokens {
+		counts[t.word]++
+	}
+
+	for _, w := range exclude {
+		if counts[w] != 0 {
+			return 0, false
+		}
+	}
+
+	hits := 0
+	for _, w := range include {
+		if counts[w] == 0 {
+			return 0, false
+		}
+		hits += counts[w]
+	}
+
+	return float32(hits) / float32(len(tokens)), true
+}
+
+// highlight wraps the query words of text in <b></b>, as ts_headline does,
+// and escapes the text around them since the snippet is rendered as HTML.
+func highlight(text string, tokens []searchToken, include []string) string {
+	var b strings.Builder
+
+	last := 0
+	for _, t := range tokens {
+		for _, w := range include {
+			if t.word == w {
+				b.WriteString(html.EscapeString(text[last:t.start]))
+				b.WriteString("<b>")
+				b.WriteString(html.EscapeString(text[t.start:t.end]))
+				b.WriteString("</b>")
+				last = t.end
+				break
+			}
+		}
+	}
+	b.WriteString(html.EscapeString(text[last:]))
+
+	return b.String()
+}
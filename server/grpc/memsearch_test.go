@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newTestSearcher(t *testing.T) *MemoryQuestionSearcher {
+	t.Helper()
+
+	day := time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)
+	s := NewMemoryQuestionSearcher()
+	for _, q := range []struct {
+		subjectId int64
+		question  *Question
+	}{
+		{1, &Question{Id: 1, Question: "How is the stock price decided?", LikesCount: 3, CreatedAt: timestamppb.New(day)}},
+		{1, &Question{Id: 2, Question: "Stock price", LikesCount: 1, CreatedAt: timestamppb.New(day.Add(24 * time.Hour)), Answered: true}},
+		{1, &Question{Id: 3, Question: "When does the market open?", LikesCount: 5, CreatedAt: timestamppb.New(day.Add(48 * time.Hour))}},
+		{2, &Question{Id: 4, Question: "Is the STOCK market a casino?", LikesCount: 0, CreatedAt: timestamppb.New(day)}},
+		{2, &Question{Id: 5, Question: "price, price and price", LikesCount: 0, CreatedAt: timestamppb.New(day)}},
+	} {
+		s.Put(q.subjectId, q.question)
+	}
+	return s
+}
+
+func TestMemoryQuestionSearcher(t *testing.T) {
+	day := time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		query *QuestionQuery
+		ids   []int64
+	}{
+		{"one word", &QuestionQuery{Query: "market"}, []int64{3, 4}},
+		{"case insensitive", &QuestionQuery{Query: "Stock"}, []int64{2, 1, 4}},
+		{"all words", &QuestionQuery{Query: "stock price"}, []int64{2, 1}},
+		{"excluded word", &QuestionQuery{Query: "stock -price"}, []int64{4}},
+		{"punctuation", &QuestionQuery{Query: "price,"}, []int64{5, 2, 1}},
+		{"no match", &QuestionQuery{Query: "bond"}, nil},
+		{"only excluded", &QuestionQuery{Query: "-stock"}, nil},
+		{"subject", &QuestionQuery{Query: "stock", SubjectId: 2}, []int64{4}},
+		{"answered", &QuestionQuery{Query: "stock", Answered: proto.Bool(true)}, []int64{2}},
+		{"unanswered", &QuestionQuery{Query: "stock", Answered: proto.Bool(false)}, []int64{1, 4}},
+		{"created from", &QuestionQuery{Query: "stock", CreatedFrom: timestamppb.New(day.Add(time.Hour))}, []int64{2}},
+		{"created to", &QuestionQuery{Query: "stock", CreatedTo: timestamppb.New(day.Add(24 * time.Hour))}, []int64{1, 4}},
+		{"limit", &QuestionQuery{Query: "price", Limit: 2}, []int64{5, 2}},
+	}
+
+	s := newTestSearcher(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := s.Search(context.Background(), tt.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []int64
+			for _, m := range list {
+				ids = append(ids, m.Question.Id)
+			}
+			if !reflect.DeepEqual(ids, tt.ids) {
+				t.Fatalf("ids = %v, want %v", ids, tt.ids)
+			}
+		})
+	}
+}
+
+func TestMemoryQuestionSearcherDelete(t *testing.T) {
+	s := newTestSearcher(t)
+	s.Delete(4)
+
+	list, err := s.Search(context.Background(), &QuestionQuery{Query: "market"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Question.Id != 3 {
+		t.Fatalf("list = %v, want question 3 only", list)
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		text    string
+		query   string
+		snippet string
+	}{
+		{"Stock price", "price", "Stock <b>price</b>"},
+		{"Is the STOCK market a casino?", "stock casino", "Is the <b>STOCK</b> market a <b>casino</b>?"},
+		{"price, price", "price", "<b>price</b>, <b>price</b>"},
+		{"주식 가격은?", "가격은", "주식 <b>가격은</b>?"},
+		{"nothing here", "stock", "nothing here"},
+		{"<img src=x onerror=alert(1)> price", "price", "&lt;img src=x onerror=alert(1)&gt; <b>price</b>"},
+		{"<b>price</b> & 'stock'", "b", "&lt;<b>b</b>&gt;price&lt;/<b>b</b>&gt; &amp; &#39;stock&#39;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			include, _ := parseSearchQuery(tt.query)
+			if got := highlight(tt.text, tokenize(tt.text), include); got != tt.snippet {
+				t.Fatalf("highlight = %q, want %q", got, tt.snippet)
+			}
+		})
+	}
+}
+
+func TestSearchQuestions(t *testing.T) {
+	b := &Board{searcher: newTestSearcher(t)}
+	tx := sentry.StartTransaction(context.Background(), "test")
+	defer tx.Finish()
+
+	_, err := b.SearchQuestions(tx.Context(), &QuestionQuery{Query: "  "})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("err = %v, want InvalidArgument", err)
+	}
+
+	list, err := b.SearchQuestions(tx.Context(), &QuestionQuery{Query: "casino"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.MatchList) != 1 || list.MatchList[0].Snippet != "Is the STOCK market a <b>casino</b>?" {
+		t.Fatalf("list = %v", list)
+	}
+}
+
+func TestSearchQuestionsEscapesMarkup(t *testing.T) {
+	s := NewMemoryQuestionSearcher()
+	s.Put(1, &Question{Id: 1, Question: `<script>alert("price")</script>`, CreatedAt: timestamppb.Now()})
+	b := &Board{searcher: s}
+	tx := sentry.StartTransaction(context.Background(), "test")
+	defer tx.Finish()
+
+	list, err := b.SearchQuestions(tx.Context(), &QuestionQuery{Query: "price"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "&lt;script&gt;alert(&#34;<b>price</b>&#34;)&lt;/script&gt;"
+	if len(list.MatchList) != 1 || list.MatchList[0].Snippet != want {
+		t.Fatalf("list = %v, want the snippet %q", list, want)
+	}
+	if q := list.MatchList[0].Question.Question; q != `<script>alert("price")</script>` {
+		t.Fatalf("question = %q, want it as written", q)
+	}
+}
@@ -0,0 +1,240 @@
+package grpc
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	// external packages
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultBookDepth = 10
+	maxBookDepth     = 50
+)
+
+type restingOrder struct {
+	order *Order
+	ticks int64
+}
+
+func (r *restingOrder) remaining() int64 {
+	return r.order.Quantity - r.order.FilledQuantity
+}
+
+type priceLevel struct {
+	ticks  int64
+	orders []*restingOrder
+}
+
+type fill struct {
+	resting  *restingOrder
+	ticks    int64
+	quantity int64
+}
+
+// orderBook keeps resting limit orders of one stock with price-time
+// priority. Callers hold mu around plan and apply so that fills computed
+// by plan are still valid when they are applied.
+type orderBook struct {
+	mu sync.Mutex
+
+	code      string
+	bids      []*priceLevel
+	asks      []*priceLevel
+	orders    map[int64]*restingOrder
+	lastTrade *Trade
+}
+
+func newOrderBook(code string) *orderBook {
+	return &orderBook{
+		code:   code,
+		orders: make(map[int64]*restingOrder),
+	}
+}
+
+func (b *orderBook) plan(o *Order) []fill {
+	levels := b.asks
+	if o.Side == Side_SELL {
+		levels = b.bids
+	}
+
+	limit := toTicks(o.Price)
+	remaining := o.Quantity - o.FilledQuantity
+
+	var fills []fill
+
+	for _, level := range levels {
+		if remaining == 0 {
+			break
+		}
+		if o.Type == OrderType_LIMIT && !crosses(o.Side, limit, level.ticks) {
+			break
+		}
+
+		for _, r := range level.orders {
+			if remaining == 0 {
+				break
+			}
+			q := r.remaining()
+			if q > remaining {
+				q = remaining
+			}
+			fills = append(fills, fill{resting: r, ticks: level.ticks, quantity: q})
+			remaining -= q
+		}
+	}
+
+	return fills
+}
+
+// selfTrade returns the resting order of the same account as o that fills
+// would trade against, if any. Such an order is never matched: the incoming
+// order is rejected and the resting one is left as it is.
+func selfTrade(o *Order, fills []fill) *Order {
+	for _, f := range fills {
+		if f.resting.order.AccountId == o.AccountId {
+			return f.resting.order
+		}
+	}
+	return nil
+}
+
+func (b *orderBook) apply(o *Order, fills []fill, counterparts []*Order, trades []*Trade) {
+	for i, f := range fills {
+		if counterparts[i].Status == OrderStatus_FILLED {
+			b.remove(counterparts[i].Id)
+		} else {
+			f.resting.order = counterparts[i]
+		}
+	}
+
+	b.remove(o.Id)
+	if o.Status == OrderStatus_OPEN || o.Status == OrderStatus_PARTIALLY_FILLED {
+		b.add(o)
+	}
+
+	if len(trades) != 0 {
+		b.lastTrade = trades[len(trades)-1]
+	}
+}
+
+func (b *orderBook) add(o *Order) {
+	r := &restingOrder{
+		order: o,
+		ticks: toTicks(o.Price),
+	}
+
+	levels := &b.asks
+	better := func(ticks int64) bool { return ticks < r.ticks }
+	if o.Side == Side_BUY {
+		levels = &b.bids
+		better = func(ticks int64) bool { return ticks > r.ticks }
+	}
+
+	i := sort.Search(len(*levels), func(i int) bool { return !better((*levels)[i].ticks) })
+	if i == len(*levels) || (*levels)[i].ticks != r.ticks {
+		*levels = append(*levels, nil)
+		copy((*levels)[i+1:], (*levels)[i:])
+		(*levels)[i] = &priceLevel{ticks: r.ticks}
+	}
+	(*levels)[i].orders = append((*levels)[i].orders, r)
+
+	b.orders[o.Id] = r
+}
+
+func (b *orderBook) remove(id int64) *restingOrder {
+	r, ok := b.orders[id]
+	if !ok {
+		return nil
+	}
+	delete(b.orders, id)
+
+	levels := &b.asks
+	if r.order.Side == Side_BUY {
+		levels = &b.bids
+	}
+
+	for i, level := range *levels {
+		if level.ticks != r.ticks {
+			continue
+		}
+		for j, o := range level.orders {
+			if o == r {
+				level.orders = append(level.orders[:j], level.orders[j+1:]...)
+				break
+			}
+		}
+		if len(level.orders) == 0 {
+			*levels = append((*levels)[:i], (*levels)[i+1:]...)
+		}
+		break
+	}
+
+	return r
+}
+
+func (b *orderBook) update(o *Order) {
+	if r, ok := b.orders[o.Id]; ok {
+		r.order = o
+	}
+}
+
+func (b *orderBook) snapshot(depth int) *OrderBook {
+	return &OrderBook{
+		Code:      b.code,
+		Bids:      depthOf(b.bids, depth),
+		Asks:      depthOf(b.asks, depth),
+		LastTrade: b.lastTrade,
+		Time:      timestamppb.Now(),
+	}
+}
+
+func depthOf(levels []*priceLevel, depth int) []*PriceLevel {
+	if len(levels) > depth {
+		levels = levels[:depth]
+	}
+
+	list := make([]*PriceLevel, 0, len(levels))
+	for _, level := range levels {
+		var quantity int64
+		for _, r := range level.orders {
+			quantity += r.remaining()
+		}
+		list = append(list, &PriceLevel{
+			Price:      fromTicks(level.ticks),
+			Quantity:   quantity,
+			OrderCount: int32(len(level.orders)),
+		})
+	}
+	return list
+}
+
+func crosses(side Side, limit, ticks int64) bool {
+	if side == Side_BUY {
+		return ticks <= limit
+	}
+	return ticks >= limit
+}
+
+func orderStatus(o *Order) OrderStatus {
+	switch {
+	case o.FilledQuantity >= o.Quantity:
+		return OrderStatus_FILLED
+	case o.Type == OrderType_MARKET:
+		return OrderStatus_CANCELLED
+	case o.FilledQuantity > 0:
+		return OrderStatus_PARTIALLY_FILLED
+	default:
+		return OrderStatus_OPEN
+	}
+}
+
+func toTicks(price float64) int64 {
+	return int64(math.Round(price * 100))
+}
+
+func fromTicks(ticks int64) float64 {
+	return float64(ticks) / 100
+}
@@ -0,0 +1,168 @@
+package grpc
+
+import (
+	"reflect"
+	"testing"
+
+	// external packages
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestBook(t *testing.T, orders ...*Order) *orderBook {
+	t.Helper()
+
+	b := newOrderBook("TEST")
+	for _, o := range orders {
+		b.add(o)
+	}
+	return b
+}
+
+func limitOrder(id int64, account string, side Side, price float64, quantity int64) *Order {
+	return &Order{
+		Id:        id,
+		AccountId: account,
+		Code:      "TEST",
+		Side:      side,
+		Type:      OrderType_LIMIT,
+		Price:     price,
+		Quantity:  quantity,
+		Status:    OrderStatus_OPEN,
+	}
+}
+
+func TestOrderBookPlan(t *testing.T) {
+	book := []*Order{
+		limitOrder(1, "alice", Side_SELL, 10.00, 5),
+		limitOrder(2, "bob", Side_SELL, 10.00, 5),
+		limitOrder(3, "alice", Side_SELL, 10.50, 10),
+		limitOrder(4, "bob", Side_BUY, 9.50, 10),
+		limitOrder(5, "carol", Side_BUY, 9.00, 10),
+	}
+
+	type planned struct {
+		id       int64
+		ticks    int64
+		quantity int64
+	}
+
+	tests := []struct {
+		name  string
+		order *Order
+		fills []planned
+	}{
+		{"no cross", limitOrder(10, "dave", Side_BUY, 9.99, 5), nil},
+		{"time priority", limitOrder(10, "dave", Side_BUY, 10.00, 7), []planned{{1, 1000, 5}, {2, 1000, 2}}},
+		{"price priority", limitOrder(10, "dave", Side_SELL, 9.00, 15), []planned{{4, 950, 10}, {5, 900, 5}}},
+		{"walks levels", limitOrder(10, "dave", Side_BUY, 11.00, 12), []planned{{1, 1000, 5}, {2, 1000, 5}, {3, 1050, 2}}},
+		{"stops at limit", limitOrder(10, "dave", Side_BUY, 10.00, 30), []planned{{1, 1000, 5}, {2, 1000, 5}}},
+		{"market", &Order{Id: 10, AccountId: "dave", Side: Side_BUY, Type: OrderType_MARKET, Quantity: 30}, []planned{{1, 1000, 5}, {2, 1000, 5}, {3, 1050, 10}}},
+		{"partially filled", &Order{Id: 10, AccountId: "dave", Side: Side_SELL, Type: OrderType_LIMIT, Price: 9.50, Quantity: 10, FilledQuantity: 6}, []planned{{4, 950, 4}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBook(t, book...)
+
+			var fills []planned
+			for _, f := range b.plan(tt.order) {
+				fills = append(fills, planned{f.resting.order.Id, f.ticks, f.quantity})
+			}
+			if !reflect.DeepEqual(fills, tt.fills) {
+				t.Fatalf("fills = %v, want %v", fills, tt.fills)
+			}
+		})
+	}
+}
+
+func TestSelfTrade(t *testing.T) {
+	book := []*Order{
+		limitOrder(1, "alice", Side_SELL, 10.00, 5),
+		limitOrder(2, "bob", Side_SELL, 10.50, 5),
+	}
+
+	tests := []struct {
+		name  string
+		order *Order
+		own   int64
+	}{
+		{"other account", limitOrder(10, "bob", Side_BUY, 10.00, 5), 0},
+		{"crosses own order", limitOrder(10, "alice", Side_BUY, 10.00, 5), 1},
+		{"own order behind", limitOrder(10, "bob", Side_BUY, 10.50, 5), 0},
+		{"own order reached", limitOrder(10, "bob", Side_BUY, 10.50, 6), 2},
+		{"own order not crossed", limitOrder(10, "bob", Side_BUY, 10.00, 10), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBook(t, book...)
+
+			var own int64
+			if o := selfTrade(tt.order, b.plan(tt.order)); o != nil {
+				own = o.Id
+			}
+			if own != tt.own {
+				t.Fatalf("own = %d, want %d", own, tt.own)
+			}
+		})
+	}
+}
+
+func TestOrderBookApply(t *testing.T) {
+	b := newTestBook(t,
+		limitOrder(1, "alice", Side_SELL, 10.00, 5),
+		limitOrder(2, "bob", Side_SELL, 10.00, 5),
+	)
+
+	o := limitOrder(10, "dave", Side_BUY, 10.00, 12)
+	fills := b.plan(o)
+
+	counterparts := make([]*Order, len(fills))
+	trades := make([]*Trade, len(fills))
+	for i, f := range fills {
+		c := proto.Clone(f.resting.order).(*Order)
+		c.FilledQuantity += f.quantity
+		c.Status = orderStatus(c)
+		counterparts[i] = c
+		o.FilledQuantity += f.quantity
+		trades[i] = &Trade{Code: "TEST", Price: fromTicks(f.ticks), Quantity: f.quantity}
+	}
+	o.Status = orderStatus(o)
+
+	b.apply(o, fills, counterparts, trades)
+
+	if o.Status != OrderStatus_PARTIALLY_FILLED {
+		t.Fatalf("status = %v, want PARTIALLY_FILLED", o.Status)
+	}
+	if len(b.asks) != 0 {
+		t.Fatalf("asks = %v, want none", b.asks)
+	}
+	if len(b.bids) != 1 || b.bids[0].ticks != 1000 || b.bids[0].orders[0].remaining() != 2 {
+		t.Fatalf("bids = %v, want 2 left at 10.00", b.bids)
+	}
+	if b.lastTrade != trades[1] {
+		t.Fatalf("lastTrade = %v, want %v", b.lastTrade, trades[1])
+	}
+}
+
+func TestOrderStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		order *Order
+		want  OrderStatus
+	}{
+		{"open", &Order{Type: OrderType_LIMIT, Quantity: 10}, OrderStatus_OPEN},
+		{"partially filled", &Order{Type: OrderType_LIMIT, Quantity: 10, FilledQuantity: 3}, OrderStatus_PARTIALLY_FILLED},
+		{"filled", &Order{Type: OrderType_LIMIT, Quantity: 10, FilledQuantity: 10}, OrderStatus_FILLED},
+		{"market remainder", &Order{Type: OrderType_MARKET, Quantity: 10, FilledQuantity: 3}, OrderStatus_CANCELLED},
+		{"market filled", &Order{Type: OrderType_MARKET, Quantity: 10, FilledQuantity: 10}, OrderStatus_FILLED},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := orderStatus(tt.order); got != tt.want {
+				t.Fatalf("orderStatus = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
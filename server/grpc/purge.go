@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"database/sql"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+)
+
+// runPurge hard-deletes the subjects and questions that were deleted more
+// than retention ago, along with the questions of such subjects.
+func (b *Board) runPurge(db *sql.DB, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		subjects, questions, err := purgeDeleted(db, time.Now().Add(-retention))
+		if err != nil {
+			sentry.CaptureException(err)
+			log.Errorf("BoardPurger: %s", err)
+			continue
+		}
+		if len(subjects) != 0 || len(questions) != 0 {
+			log.Infof("BoardPurger: purged %d subjects and %d questions", len(subjects), len(questions))
+		}
+	}
+}
+
+func purgeDeleted(db *sql.DB, cutoff time.Time) ([]int64, []int64, error) {
+	var subjectIds, questionIds []int64
+
+	err := inTx(db, func(tx *sql.Tx) error {
+		// lock the subjects first, so none of them is restored after its
+		// questions are gone
+		rows, err := tx.Query("SELECT id FROM subject WHERE deleted_at < $1 FOR UPDATE", cutoff)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			var id int64
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return err
+			}
+			subjectIds = append(subjectIds, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		err = tx.QueryRow(`
+WITH purged AS (
+  DELETE FROM question
+   WHERE deleted_at < $1 OR subject_id = ANY($2)
+  RETURNING id
+)
+SELECT COALESCE(array_agg(id), '{}') FROM purged`, cutoff, pq.Array(subjectIds)).Scan(pq.Array(&questionIds))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec("DELETE FROM question_edit WHERE question_id = ANY($1)", pq.Array(questionIds))
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec("DELETE FROM subject WHERE id = ANY($1)", pq.Array(subjectIds))
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		entries := make([]auditEntry, 0, len(subjectIds)+len(questionIds))
+		for _, id := range questionIds {
+			entries = append(entries, auditEntry{method: "BoardPurger", targetType: "question", targetId: id, createdAt: now})
+		}
+		for _, id := range subjectIds {
+			entries = append(entries, auditEntry{method: "BoardPurger", targetType: "subject", targetId: id, createdAt: now})
+		}
+		return insertAudit(tx, entries...)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return subjectIds, questionIds, nil
+}
@@ -0,0 +1,281 @@
+package grpc
+
+import (
+	"database/sql"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"github.com/lib/pq"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	tradingSecondsPerYear = 252 * 6.5 * 60 * 60
+	meanTickVolume        = 1000
+)
+
+type PriceFeedConfig struct {
+	Seed         int64
+	Interval     time.Duration
+	Drift        float64
+	Volatility   float64
+	Volatilities map[string]float64
+	Spread       float64
+}
+
+type simStock struct {
+	id         int64
+	code       string
+	volatility float64
+	last       *Quote
+}
+
+// PriceFeed simulates quotes for every row in stocks with a geometric
+// brownian motion. The same seed and stock set always produce the same
+// series, no matter how fast the feed is ticking.
+//
+// tickMu is held for a whole tick, from simulating it to handing it to the
+// listeners, so hold can keep ticks out while the prices are rescaled.
+type PriceFeed struct {
+	db       *sql.DB
+	config   PriceFeedConfig
+	calendar *MarketCalendar
+	rng      *rand.Rand
+	quotes   *broker[*Quote]
+
+	tickMu sync.Mutex
+
+	mu        sync.RWMutex
+	stocks    []*simStock
+	listeners []func([]*Quote)
+}
+
+func NewPriceFeed(db *sql.DB, config PriceFeedConfig, calendar *MarketCalendar) *PriceFeed {
+	return &PriceFeed{
+		db:       db,
+		config:   config,
+		calendar: calendar,
+		rng:      rand.New(rand.NewSource(config.Seed)),
+		quotes:   newBroker[*Quote](),
+	}
+}
+
+func (f *PriceFeed) Run() {
+	ticker := time.NewTicker(f.config.Interval)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		f.runTick(now)
+	}
+}
+
+// runTick simulates, persists and publishes one tick, unless the session
+// is not open at now.
+func (f *PriceFeed) runTick(now time.Time) {
+	if f.calendar.State(now) != SessionState_SESSION_OPEN {
+		return
+	}
+
+	f.tickMu.Lock()
+	defer f.tickMu.Unlock()
+
+	if err := f.load(); err != nil {
+		sentry.CaptureException(err)
+		log.Errorf("PriceFeed: failed to load stocks. %s", err)
+	}
+
+	quotes := f.tick(now)
+
+	if err := insertQuotes(f.db, f.stockIds(), quotes); err != nil {
+		sentry.CaptureException(err)
+		log.Errorf("PriceFeed: failed to persist quotes. %s", err)
+	}
+
+	for _, fn := range f.listeners {
+		fn(quotes)
+	}
+	for _, q := range quotes {
+		f.quotes.publish(q)
+	}
+}
+
+// hold waits for a running tick to finish and keeps the next one from
+// starting until the returned func is called.
+func (f *PriceFeed) hold() func() {
+	f.tickMu.Lock()
+	return f.tickMu.Unlock
+}
+
+// Listen registers fn to receive every tick synchronously. Unlike the
+// quotes broker it never drops a tick, so it must be set up before Run.
+func (f *PriceFeed) Listen(fn func([]*Quote)) {
+	f.listeners = append(f.listeners, fn)
+}
+
+func (f *PriceFeed) Latest(code string) *Quote {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	for _, s := range f.stocks {
+		if s.code == code {
+			return s.last
+		}
+	}
+	return nil
+}
+
+func (f *PriceFeed) Snapshot(codes []string) []*Quote {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	var list []*Quote
+	for _, s := range f.stocks {
+		if s.last != nil && (len(codes) == 0 || containsCode(codes, s.code)) {
+			list = append(list, s.last)
+		}
+	}
+	return list
+}
+
+func (f *PriceFeed) adjust(code string, ratio float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, s := range f.stocks {
+		if s.code != code || s.last == nil {
+			continue
+		}
+		last := proto.Clone(s.last).(*Quote)
+		last.Bid = roundPrice(last.Bid / ratio)
+		last.Ask = roundPrice(last.Ask / ratio)
+		last.Last = roundPrice(last.Last / ratio)
+		s.last = last
+	}
+}
+
+func (f *PriceFeed) load() error {
+	rows, err := f.db.Query("SELECT id, code FROM stocks ORDER BY code;")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	known := make(map[string]*simStock, len(f.stocks))
+	for _, s := range f.stocks {
+		known[s.code] = s
+	}
+
+	var stocks []*simStock
+	for rows.Next() {
+		s := &simStock{}
+		if err := rows.Scan(&s.id, &s.code); err != nil {
+			return err
+		}
+		if k, ok := known[s.code]; ok {
+			s = k
+		}
+		s.volatility = f.config.Volatility
+		if v, ok := f.config.Volatilities[s.code]; ok {
+			s.volatility = v
+		}
+		stocks = append(stocks, s)
+	}
+
+	sort.Slice(stocks, func(i, j int) bool { return stocks[i].code < stocks[j].code })
+	f.stocks = stocks
+
+	return rows.Err()
+}
+
+func (f *PriceFeed) tick(now time.Time) []*Quote {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	dt := f.config.Interval.Seconds() / tradingSecondsPerYear
+	ts := timestamppb.New(now)
+	quotes := make([]*Quote, 0, len(f.stocks))
+
+	for _, s := range f.stocks {
+		var last float64
+		if s.last == nil {
+			last = roundPrice(1000 + f.rng.Float64()*99000)
+		} else {
+			z := f.rng.NormFloat64()
+			drift := (f.config.Drift - s.volatility*s.volatility/2) * dt
+			last = roundPrice(s.last.Last * math.Exp(drift+s.volatility*math.Sqrt(dt)*z))
+		}
+		half := last * f.config.Spread / 2
+
+		s.last = &Quote{
+			Code:   s.code,
+			Bid:    roundPrice(last - half),
+			Ask:    roundPrice(last + half),
+			Last:   last,
+			Volume: int64(f.rng.ExpFloat64() * meanTickVolume),
+			Time:   ts,
+		}
+		quotes = append(quotes, s.last)
+	}
+
+	return quotes
+}
+
+func (f *PriceFeed) stockIds() map[string]int64 {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	ids := make(map[string]int64, len(f.stocks))
+	for _, s := range f.stocks {
+		ids[s.code] = s.id
+	}
+	return ids
+}
+
+func insertQuotes(db *sql.DB, stockIds map[string]int64, quotes []*Quote) error {
+	if len(quotes) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(quotes))
+	bids := make([]float64, len(quotes))
+	asks := make([]float64, len(quotes))
+	lasts := make([]float64, len(quotes))
+	volumes := make([]int64, len(quotes))
+	for i, q := range quotes {
+		ids[i] = stockIds[q.Code]
+		bids[i] = q.Bid
+		asks[i] = q.Ask
+		lasts[i] = q.Last
+		volumes[i] = q.Volume
+	}
+
+	_, err := db.Exec(`
+INSERT INTO quote(stock_id, bid, ask, last, volume, time)
+SELECT unnest($1::bigint[]), unnest($2::float8[]), unnest($3::float8[]), unnest($4::float8[]), unnest($5::bigint[]), $6`,
+		pq.Array(ids), pq.Array(bids), pq.Array(asks), pq.Array(lasts), pq.Array(volumes), quotes[0].Time.AsTime())
+	return err
+}
+
+func roundPrice(p float64) float64 {
+	return math.Round(p*100) / 100
+}
+
+func containsCode(codes []string, code string) bool {
+	for _, c := range codes {
+		if strings.EqualFold(c, code) {
+			return true
+		}
+	}
+	return false
+}
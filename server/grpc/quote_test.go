@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	// external packages
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestPriceFeed(seed int64, interval time.Duration, calendar *MarketCalendar) *PriceFeed {
+	f := NewPriceFeed(nil, PriceFeedConfig{
+		Seed:         seed,
+		Interval:     interval,
+		Drift:        0.05,
+		Volatility:   0.3,
+		Volatilities: map[string]float64{"BBB": 0.6},
+		Spread:       0.001,
+	}, calendar)
+	f.stocks = []*simStock{
+		{id: 1, code: "AAA", volatility: 0.3},
+		{id: 2, code: "BBB", volatility: 0.6},
+	}
+	return f
+}
+
+func TestPriceFeedSeed(t *testing.T) {
+	start := time.Date(2026, 10, 13, 1, 0, 0, 0, time.UTC)
+	series := func(seed int64, interval time.Duration) [][]*Quote {
+		f := newTestPriceFeed(seed, interval, nil)
+		var ticks [][]*Quote
+		for i := 0; i < 20; i++ {
+			ticks = append(ticks, f.tick(start.Add(time.Duration(i)*time.Second)))
+		}
+		return ticks
+	}
+
+	a := series(42, time.Second)
+	b := series(42, time.Second)
+	for i := range a {
+		for j := range a[i] {
+			if !proto.Equal(a[i][j], b[i][j]) {
+				t.Fatalf("tick %d of %s = %v, want %v", i, a[i][j].Code, b[i][j], a[i][j])
+			}
+		}
+	}
+
+	other := series(43, time.Second)
+	if proto.Equal(a[0][0], other[0][0]) {
+		t.Fatal("another seed produced the same first quote")
+	}
+
+	// the pace only scales the moves, not the draws of the seed
+	slow := series(42, time.Minute)
+	if a[0][0].Last != slow[0][0].Last {
+		t.Fatalf("first price = %v at another interval, want %v", slow[0][0].Last, a[0][0].Last)
+	}
+}
+
+func TestPriceFeedClosedSession(t *testing.T) {
+	c := newTestCalendar(t)
+	seoul, _ := time.LoadLocation("Asia/Seoul")
+
+	f := newTestPriceFeed(42, time.Second, c)
+	ticks := 0
+	f.Listen(func(quotes []*Quote) { ticks++ })
+
+	for _, now := range []time.Time{
+		time.Date(2026, 10, 17, 10, 0, 0, 0, seoul), // saturday
+		time.Date(2026, 10, 9, 10, 0, 0, 0, seoul),  // holiday
+		time.Date(2026, 10, 13, 8, 45, 0, 0, seoul), // pre-open
+		time.Date(2026, 10, 13, 16, 0, 0, 0, seoul), // after close
+	} {
+		f.runTick(now)
+	}
+
+	if ticks != 0 {
+		t.Fatalf("%d ticks while the session was not open, want none", ticks)
+	}
+	if q := f.Latest("AAA"); q != nil {
+		t.Fatalf("latest quote = %v, want none", q)
+	}
+}
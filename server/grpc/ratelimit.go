@@ -0,0 +1,225 @@
+package grpc
+
+import (
+	"container/list"
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	// external packages
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RateLimit is a token bucket that refills Rate tokens per second up to
+// Burst. Every call takes one token. Rate must be positive.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimiter takes a token from the bucket of key. When the bucket is
+// empty it returns false and how long until the next token.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, limit RateLimit) (bool, time.Duration, error)
+}
+
+func RateLimitUnaryServerInterceptor(limiter RateLimiter, limits map[string]RateLimit) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkRateLimit(ctx, limiter, limits, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func RateLimitStreamServerInterceptor(limiter RateLimiter, limits map[string]RateLimit) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkRateLimit(ss.Context(), limiter, limits, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// checkRateLimit lets the call through when the limiter fails, so an
+// unavailable backend does not take the whole service down.
+func checkRateLimit(ctx context.Context, limiter RateLimiter, limits map[string]RateLimit, method string) error {
+	limit, ok := limits[method]
+	if !ok {
+		return nil
+	}
+
+	client := clientIdentity(ctx)
+	if client == "" {
+		client = "ip:unknown"
+	}
+	allowed, wait, err := limiter.Allow(ctx, method+"|"+client, limit)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("RateLimit")
+		return nil
+	}
+	if allowed {
+		return nil
+	}
+
+	loggerFromContext(ctx).Warnf("RateLimit: %s exceeded the limit of %s", client, method)
+
+	st, err := status.New(codes.ResourceExhausted, "too many requests, try again later").
+		WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)})
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, "too many requests, try again later")
+	}
+	return st.Err()
+}
+
+// clientIdentity identifies the caller by the account of its verified
+// token, or else by its address, as forwarded by a trusted proxy. Nothing
+// else the client sends is used, since a client could change it on every
+// call to start over with a fresh bucket. It is empty when neither is
+// known.
+func clientIdentity(ctx context.Context) string {
+	if p := principalFromContext(ctx); !p.Anonymous() {
+		return "user:" + p.UserId
+	}
+
+	if addr := clientAddrFromContext(ctx); addr != "" {
+		return "ip:" + addr
+	}
+	return ""
+}
+
+type tokenBucket struct {
+	key     string
+	limit   RateLimit
+	tokens  float64
+	updated time.Time
+}
+
+// refill adds the tokens earned since the last update.
+func (b *tokenBucket) refill(now time.Time) {
+	b.tokens = math.Min(float64(b.limit.Burst), b.tokens+now.Sub(b.updated).Seconds()*b.limit.Rate)
+	b.updated = now
+}
+
+// MemoryRateLimiter keeps up to size buckets in process, so every replica
+// limits on its own. Past size it drops the least recently used bucket,
+// which at worst lets that client start over with a full one.
+type MemoryRateLimiter struct {
+	size int
+
+	mu      sync.Mutex
+	lru     *list.List
+	buckets map[string]*list.Element
+	swept   time.Time
+}
+
+func NewMemoryRateLimiter(size int) *MemoryRateLimiter {
+	return &MemoryRateLimiter{
+		size:    size,
+		lru:     list.New(),
+		buckets: make(map[string]*list.Element),
+		swept:   time.Now(),
+	}
+}
+
+func (l *MemoryRateLimiter) Allow(_ context.Context, key string, limit RateLimit) (bool, time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.swept) > time.Minute {
+		l.sweep(now)
+	}
+
+	var b *tokenBucket
+	if e, ok := l.buckets[key]; ok {
+		b = e.Value.(*tokenBucket)
+		l.lru.MoveToFront(e)
+	} else {
+		b = &tokenBucket{key: key, tokens: float64(limit.Burst), updated: now}
+		l.buckets[key] = l.lru.PushFront(b)
+		for l.size > 0 && l.lru.Len() > l.size {
+			l.remove(l.lru.Back())
+		}
+	}
+	b.limit = limit
+	b.refill(now)
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0, nil
+	}
+	return false, time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second)), nil
+}
+
+// sweep drops the buckets that have refilled, since a new bucket starts
+// full anyway.
+func (l *MemoryRateLimiter) sweep(now time.Time) {
+	for _, e := range l.buckets {
+		b := e.Value.(*tokenBucket)
+		if b.refill(now); b.tokens >= float64(b.limit.Burst) {
+			l.remove(e)
+		}
+	}
+	l.swept = now
+}
+
+func (l *MemoryRateLimiter) remove(e *list.Element) {
+	b := l.lru.Remove(e).(*tokenBucket)
+	delete(l.buckets, b.key)
+}
+
+// rateLimitScript refills and takes a token atomically on the Redis clock,
+// so replicas share one bucket per key regardless of their own clocks.
+var rateLimitScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) + tonumber(t[2]) / 1000000
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'updated')
+local tokens = tonumber(bucket[1]) or burst
+local updated = tonumber(bucket[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - updated) * rate)
+
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  wait = math.ceil((1 - tokens) / rate * 1000)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst / rate * 1000) + 1000)
+return {allowed, wait}
+`)
+
+// RedisRateLimiter shares the buckets between every replica.
+type RedisRateLimiter struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+func NewRedisRateLimiter(client redis.UniversalClient, prefix string) *RedisRateLimiter {
+	return &RedisRateLimiter{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (l *RedisRateLimiter) Allow(ctx context.Context, key string, limit RateLimit) (bool, time.Duration, error) {
+	result, err := rateLimitScript.Run(ctx, l.client, []string{l.prefix + key}, limit.Rate, limit.Burst).Int64Slice()
+	if err != nil {
+		return false, 0, err
+	}
+
+	return result[0] == 1, time.Duration(result[1]) * time.Millisecond, nil
+}
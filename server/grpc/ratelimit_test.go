@@ -0,0 +1,199 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	// external packages
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func peerContext(addr string) context.Context {
+	tcp, _ := net.ResolveTCPAddr("tcp", addr)
+	return peer.NewContext(context.Background(), &peer.Peer{Addr: tcp})
+}
+
+func TestClientIdentity(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "verified user",
+			ctx:  context.WithValue(peerContext("192.0.2.1:5000"), principalKey, &Principal{UserId: "alice"}),
+			want: "user:alice",
+		},
+		{
+			name: "anonymous by address",
+			ctx:  peerContext("192.0.2.1:5000"),
+			want: "ip:192.0.2.1",
+		},
+		{
+			name: "ipv6 address",
+			ctx:  peerContext("[2001:db8::1]:5000"),
+			want: "ip:2001:db8::1",
+		},
+		{
+			name: "unsigned headers are ignored",
+			ctx: metadata.NewIncomingContext(peerContext("192.0.2.1:5000"),
+				metadata.Pairs("guesttoken", "g1", "userid", "alice")),
+			want: "ip:192.0.2.1",
+		},
+		{
+			name: "forwarded by a trusted proxy",
+			ctx:  forwardedContext("10.0.0.2:5000", "203.0.113.1", []*net.IPNet{{IP: net.IPv4(10, 0, 0, 0), Mask: net.CIDRMask(8, 32)}}),
+			want: "ip:203.0.113.1",
+		},
+		{
+			name: "forwarded by anyone else",
+			ctx:  forwardedContext("192.0.2.1:5000", "203.0.113.1", nil),
+			want: "ip:192.0.2.1",
+		},
+		{
+			name: "unknown",
+			ctx:  context.Background(),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clientIdentity(tt.ctx); got != tt.want {
+				t.Fatalf("clientIdentity = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckRateLimit(t *testing.T) {
+	const method = "/board.Board/Like"
+	limiter := NewMemoryRateLimiter(0)
+	limits := map[string]RateLimit{method: {Rate: 0.001, Burst: 2}}
+
+	// an anonymous client can't escape its bucket by changing headers
+	for i, token := range []string{"g1", "g2", "g3"} {
+		ctx := metadata.NewIncomingContext(peerContext("192.0.2.1:5000"), metadata.Pairs("guesttoken", token))
+		err := checkRateLimit(ctx, limiter, limits, method)
+		if i < 2 {
+			if err != nil {
+				t.Fatalf("call %d = %v, want it allowed", i, err)
+			}
+			continue
+		}
+
+		st := status.Convert(err)
+		if st.Code() != codes.ResourceExhausted {
+			t.Fatalf("call %d = %v, want ResourceExhausted", i, err)
+		}
+		if len(st.Details()) != 1 {
+			t.Fatalf("details = %v, want RetryInfo", st.Details())
+		}
+		if info, ok := st.Details()[0].(*errdetails.RetryInfo); !ok || info.RetryDelay.AsDuration() <= 0 {
+			t.Fatalf("details = %v, want a positive retry delay", st.Details())
+		}
+	}
+
+	if err := checkRateLimit(peerContext("192.0.2.2:5000"), limiter, limits, method); err != nil {
+		t.Fatalf("another address = %v, want its own bucket", err)
+	}
+	if err := checkRateLimit(peerContext("192.0.2.1:5000"), limiter, limits, "/board.Board/ListSubjects"); err != nil {
+		t.Fatalf("method without a limit = %v, want it allowed", err)
+	}
+}
+
+func TestCheckRateLimitBehindProxy(t *testing.T) {
+	const method = "/board.Board/Like"
+	limiter := NewMemoryRateLimiter(0)
+	limits := map[string]RateLimit{method: {Rate: 0.001, Burst: 1}}
+	trusted, _ := ParseTrustedProxies("10.0.0.2")
+
+	// two guests relayed by the same web server
+	alice := forwardedContext("10.0.0.2:5000", "203.0.113.1", trusted)
+	bob := forwardedContext("10.0.0.2:5000", "203.0.113.2", trusted)
+
+	if err := checkRateLimit(alice, limiter, limits, method); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkRateLimit(alice, limiter, limits, method); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("second call = %v, want ResourceExhausted", err)
+	}
+	if err := checkRateLimit(bob, limiter, limits, method); err != nil {
+		t.Fatalf("another guest behind the proxy = %v, want its own bucket", err)
+	}
+}
+
+func TestMemoryRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	l := NewMemoryRateLimiter(0)
+	limit := RateLimit{Rate: 1000, Burst: 3}
+
+	for i := 0; i < 3; i++ {
+		if ok, _, _ := l.Allow(ctx, "k", limit); !ok {
+			t.Fatalf("call %d was limited within the burst", i)
+		}
+	}
+	ok, wait, _ := l.Allow(ctx, "k", limit)
+	if ok || wait <= 0 {
+		t.Fatalf("Allow = %v, %v, want limited with a wait", ok, wait)
+	}
+
+	time.Sleep(wait + time.Millisecond)
+	if ok, _, _ := l.Allow(ctx, "k", limit); !ok {
+		t.Fatal("no token after the wait")
+	}
+}
+
+func TestMemoryRateLimiterSize(t *testing.T) {
+	ctx := context.Background()
+	l := NewMemoryRateLimiter(2)
+	limit := RateLimit{Rate: 0.001, Burst: 1}
+
+	l.Allow(ctx, "a", limit)
+	l.Allow(ctx, "b", limit)
+	l.Allow(ctx, "a", limit)
+	l.Allow(ctx, "c", limit)
+
+	if len(l.buckets) != 2 || l.lru.Len() != 2 {
+		t.Fatalf("%d buckets, %d in lru, want 2", len(l.buckets), l.lru.Len())
+	}
+	if _, ok := l.buckets["b"]; ok {
+		t.Fatal("the least recently used bucket was kept")
+	}
+	if ok, _, _ := l.Allow(ctx, "a", limit); ok {
+		t.Fatal("a recently used bucket was dropped")
+	}
+}
+
+func TestRedisRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer client.Close()
+
+	// two replicas share the bucket
+	a := NewRedisRateLimiter(client, "test:")
+	b := NewRedisRateLimiter(client, "test:")
+	limit := RateLimit{Rate: 0.001, Burst: 2}
+
+	for i, l := range []*RedisRateLimiter{a, b} {
+		if ok, _, err := l.Allow(ctx, "k", limit); !ok || err != nil {
+			t.Fatalf("call %d = %v, %v, want it allowed", i, ok, err)
+		}
+	}
+	ok, wait, err := a.Allow(ctx, "k", limit)
+	if ok || err != nil || wait <= 0 {
+		t.Fatalf("Allow = %v, %v, %v, want limited with a wait", ok, wait, err)
+	}
+	if ok, _, err := a.Allow(ctx, "other", limit); !ok || err != nil {
+		t.Fatalf("another key = %v, %v, want its own bucket", ok, err)
+	}
+}
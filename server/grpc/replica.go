@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/status"
+)
+
+// replicaRouter sends Board reads to a read replica. A client reads from
+// the primary for window after its own write, since the replica may not
+// have replayed it yet, and every read goes to the primary while the
+// replica is unhealthy.
+//
+// A client is its clientIdentity: the user of its verified token, or else
+// its address. No header can pin or unpin someone else. A cached list is
+// filled for every client, so its fill is a client of its own, pinned by
+// the writes that invalidate its key only.
+type replicaRouter struct {
+	db      *sql.DB
+	primary *boardStatements
+	window  time.Duration
+
+	mu      sync.Mutex
+	replica *boardStatements
+	healthy bool
+	writes  map[string]time.Time
+}
+
+func newReplicaRouter(db *sql.DB, primary *boardStatements, window time.Duration) *replicaRouter {
+	r := &replicaRouter{
+		db:      db,
+		primary: primary,
+		window:  window,
+		writes:  make(map[string]time.Time),
+	}
+	r.check()
+	return r
+}
+
+// statements returns the statements to read with for client.
+func (r *replicaRouter) statements(client string) *boardStatements {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.healthy {
+		return r.primary
+	}
+	if time.Since(r.writes[client]) < r.window {
+		return r.primary
+	}
+	return r.replica
+}
+
+func (r *replicaRouter) wrote(clients ...string) {
+	now := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, client := range clients {
+		if client != "" {
+			r.writes[client] = now
+		}
+	}
+}
+
+func (r *replicaRouter) fail(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.healthy {
+		log.Errorf("ReplicaRouter: replica is unhealthy, reading from primary. %s", err)
+		r.healthy = false
+	}
+}
+
+func (r *replicaRouter) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		r.check()
+		r.sweep()
+	}
+}
+
+// check pings the replica and prepares its statements once it is first
+// reachable, so a replica that is down at startup joins later.
+func (r *replicaRouter) check() {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	err := r.db.PingContext(ctx)
+	if err == nil && r.replica == nil {
+		var replica *boardStatements
+		if replica, err = prepareBoardStatements(r.db, true); err == nil {
+			r.mu.Lock()
+			r.replica = replica
+			r.mu.Unlock()
+		}
+	}
+
+	if err != nil {
+		sentry.CaptureException(err)
+		r.fail(err)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.healthy {
+		log.Infof("ReplicaRouter: replica is healthy")
+		r.healthy = true
+	}
+}
+
+func (r *replicaRouter) sweep() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for client, t := range r.writes {
+		if time.Since(t) >= r.window {
+			delete(r.writes, client)
+		}
+	}
+}
+
+// read runs fn against the replica when client may read from it, and
+// again against the primary when the replica fails.
+func (b *Board) read(client string, fn func(*boardStatements) error) error {
+	if b.replica == nil {
+		return fn(b.stmts)
+	}
+
+	stmts := b.replica.statements(client)
+	err := fn(stmts)
+	if err == nil || stmts == b.stmts {
+		return err
+	}
+	if _, ok := status.FromError(err); ok || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	b.replica.fail(err)
+	return fn(b.stmts)
+}
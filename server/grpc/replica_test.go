@@ -0,0 +1,169 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	// external packages
+	"google.golang.org/grpc/metadata"
+)
+
+func TestReplicaRouterPinsWriters(t *testing.T) {
+	primary := &boardStatements{}
+	replica := &boardStatements{}
+	newRouter := func() *replicaRouter {
+		return &replicaRouter{
+			primary: primary,
+			replica: replica,
+			healthy: true,
+			window:  time.Minute,
+			writes:  make(map[string]time.Time),
+		}
+	}
+
+	alice := context.WithValue(peerContext("192.0.2.1:5000"), principalKey, &Principal{UserId: "alice"})
+	bob := context.WithValue(peerContext("192.0.2.1:5000"), principalKey, &Principal{UserId: "bob"})
+	guest := peerContext("192.0.2.1:5000")
+	other := peerContext("192.0.2.2:5000")
+	// a guest claiming to be alice in an unsigned header
+	spoofed := metadata.NewIncomingContext(peerContext("192.0.2.3:5000"), metadata.Pairs("userid", "alice"))
+
+	tests := []struct {
+		name    string
+		writer  context.Context
+		pinned  []context.Context
+		replica []context.Context
+	}{
+		{
+			name:    "verified user",
+			writer:  alice,
+			pinned:  []context.Context{alice},
+			replica: []context.Context{bob, guest, other, spoofed},
+		},
+		{
+			name:    "anonymous address",
+			writer:  guest,
+			pinned:  []context.Context{guest},
+			replica: []context.Context{alice, other, spoofed},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRouter()
+			r.wrote(clientIdentity(tt.writer))
+
+			for i, ctx := range tt.pinned {
+				if r.statements(clientIdentity(ctx)) != primary {
+					t.Fatalf("pinned client %d reads from the replica", i)
+				}
+			}
+			for i, ctx := range tt.replica {
+				if r.statements(clientIdentity(ctx)) != replica {
+					t.Fatalf("client %d was pinned by another client's write", i)
+				}
+			}
+		})
+	}
+}
+
+func TestReplicaRouterWindowAndHealth(t *testing.T) {
+	primary := &boardStatements{}
+	replica := &boardStatements{}
+	r := &replicaRouter{
+		primary: primary,
+		replica: replica,
+		healthy: true,
+		window:  time.Minute,
+		writes:  map[string]time.Time{"user:alice": time.Now().Add(-2 * time.Minute)},
+	}
+
+	if r.statements("user:alice") != replica {
+		t.Fatal("client is pinned after the window")
+	}
+	r.sweep()
+	if len(r.writes) != 0 {
+		t.Fatalf("sweep kept %d writes past the window", len(r.writes))
+	}
+
+	r.fail(context.DeadlineExceeded)
+	if r.statements("user:alice") != primary {
+		t.Fatal("reads an unhealthy replica")
+	}
+}
+
+func TestLikesFlushedPinsClients(t *testing.T) {
+	primary := &boardStatements{}
+	replica := &boardStatements{}
+	board := &Board{
+		stmts: primary,
+		replica: &replicaRouter{
+			primary: primary,
+			replica: replica,
+			healthy: true,
+			window:  time.Minute,
+			writes:  make(map[string]time.Time),
+		},
+	}
+
+	board.likesFlushed([]int64{1}, []string{"ip:192.0.2.1", "user:alice"})
+
+	for _, client := range []string{"ip:192.0.2.1", "user:alice"} {
+		if board.replica.statements(client) != primary {
+			t.Fatalf("%s reads its flushed like from the replica", client)
+		}
+	}
+	if board.replica.statements("user:bob") != replica {
+		t.Fatal("a client without flushed likes was pinned")
+	}
+}
+
+// TestBoardWrotePinsCacheFills has a write pin the fills of the lists it
+// invalidates, and only those, so the replica keeps serving the other
+// lists under write load.
+func TestBoardWrotePinsCacheFills(t *testing.T) {
+	primary := &boardStatements{}
+	replica := &boardStatements{}
+	board := &Board{
+		stmts: primary,
+		cache: newBoardCache(NewMemoryCache(0, time.Minute)),
+		replica: &replicaRouter{
+			primary: primary,
+			replica: replica,
+			healthy: true,
+			window:  time.Minute,
+			writes:  make(map[string]time.Time),
+		},
+	}
+	alice := context.WithValue(peerContext("192.0.2.1:5000"), principalKey, &Principal{UserId: "alice"})
+	bob := context.WithValue(peerContext("192.0.2.2:5000"), principalKey, &Principal{UserId: "bob"})
+
+	board.questionsChanged(alice, 1)
+
+	tests := []struct {
+		name string
+		key  string
+		want *boardStatements
+	}{
+		{"written list", questionsCacheKey(1), primary},
+		{"list of another subject", questionsCacheKey(2), replica},
+		{"subject list", subjectsCacheKey, replica},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := board.replica.statements(board.readClient(bob, tt.key)); got != tt.want {
+				t.Fatalf("fill of %s reads from the wrong database", tt.key)
+			}
+		})
+	}
+
+	if board.replica.statements(clientIdentity(alice)) != primary {
+		t.Fatal("the writer reads from the replica")
+	}
+	board.subjectsChanged(bob)
+	if board.replica.statements(board.readClient(alice, subjectsCacheKey)) != primary {
+		t.Fatal("the fill of the subject list reads from the replica after a write")
+	}
+}
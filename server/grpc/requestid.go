@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	// external packages
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const requestIdKey string = "x-request-id"
+
+// RequestIdUnaryServerInterceptor accepts the x-request-id of the caller,
+// or generates one, and echoes it in the response header and trailer. It
+// runs first so every other interceptor can tag its output with it.
+func RequestIdUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = withRequestId(ctx)
+
+		md := metadata.Pairs(requestIdKey, requestIdFromContext(ctx))
+		grpc.SetHeader(ctx, md)
+		defer grpc.SetTrailer(ctx, md)
+
+		return handler(ctx, req)
+	}
+}
+
+func RequestIdStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withRequestId(ss.Context())
+
+		md := metadata.Pairs(requestIdKey, requestIdFromContext(ctx))
+		ss.SetHeader(md)
+		defer ss.SetTrailer(md)
+
+		stream := grpc_middleware.WrapServerStream(ss)
+		stream.WrappedContext = ctx
+		return handler(srv, stream)
+	}
+}
+
+func withRequestId(ctx context.Context) context.Context {
+	requestId := ""
+	if values := metadata.ValueFromIncomingContext(ctx, requestIdKey); len(values) != 0 {
+		requestId = values[0]
+	}
+	if !ValidRequestId(requestId) {
+		requestId = NewRequestId()
+	}
+	return context.WithValue(ctx, requestIdKey, requestId)
+}
+
+func requestIdFromContext(ctx context.Context) string {
+	requestId, _ := ctx.Value(requestIdKey).(string)
+	return requestId
+}
+
+// ValidRequestId accepts the ids of callers only when they are short and
+// printable, since they end up in logs and response headers verbatim.
+func ValidRequestId(requestId string) bool {
+	if requestId == "" || len(requestId) > 128 {
+		return false
+	}
+	for _, c := range requestId {
+		if c < 0x21 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// NewRequestId returns a random id for a request that came without one.
+func NewRequestId() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
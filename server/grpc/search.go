@@ -0,0 +1,128 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+// escapedQuestion escapes the question as html.EscapeString does. The
+// snippet is rendered as HTML, so the only tags in it must be the <b></b>
+// of ts_headline.
+const escapedQuestion = `replace(replace(replace(replace(replace(question,
+           '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&#34;'), '''', '&#39;')`
+
+// QuestionSearcher returns the questions matching a query, best first.
+// Board searches Postgres; MemoryQuestionSearcher mirrors it over questions
+// held in memory.
+type QuestionSearcher interface {
+	Search(ctx context.Context, query *QuestionQuery) ([]*QuestionMatch, error)
+}
+
+type postgresSearcher struct {
+	db *sql.DB
+}
+
+func (s *postgresSearcher) Search(ctx context.Context, query *QuestionQuery) ([]*QuestionMatch, error) {
+	return searchQuestions(ctx, s.db, query)
+}
+
+func (b *Board) SearchQuestions(ctx context.Context, query *QuestionQuery) (*QuestionMatchList, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/SearchQuestions")
+	defer span.Finish()
+
+	if len(strings.TrimSpace(query.GetQuery())) == 0 {
+		loggerFromContext(ctx).Errorf("SearchQuestions: empty input 'query'")
+		return nil, status.Error(codes.InvalidArgument, "empty input 'query'")
+	}
+
+	list, err := b.searcher.Search(ctx, query)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("SearchQuestions")
+		return nil, err
+	}
+
+	return &QuestionMatchList{
+		MatchList: list,
+	}, nil
+}
+
+func searchQuestions(ctx context.Context, db *sql.DB, query *QuestionQuery) ([]*QuestionMatch, error) {
+	conds := []string{"search @@ q"}
+	args := []interface{}{query.Query}
+
+	if query.SubjectId != 0 {
+		args = append(args, query.SubjectId)
+		conds = append(conds, fmt.Sprintf("subject_id = $%d", len(args)))
+	}
+	if query.CreatedFrom != nil {
+		args = append(args, query.CreatedFrom.AsTime())
+		conds = append(conds, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if query.CreatedTo != nil {
+		args = append(args, query.CreatedTo.AsTime())
+		conds = append(conds, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	if query.Answered != nil {
+		args = append(args, query.GetAnswered())
+		conds = append(conds, fmt.Sprintf("answered = $%d", len(args)))
+	}
+
+	args = append(args, searchLimit(query))
+
+	stmt := fmt.Sprintf(`
+SELECT `+questionColumns+`,
+       ts_rank(search, q) AS rank,
+       ts_headline('simple', `+escapedQuestion+`, q, 'StartSel=<b>, StopSel=</b>, MaxFragments=2')
+  FROM question, websearch_to_tsquery('simple', $1) q
+ WHERE deleted_at IS NULL
+   AND subject_id IN (SELECT id FROM subject WHERE deleted_at IS NULL)
+   AND %s
+ ORDER BY rank DESC, likes DESC, id ASC
+ LIMIT $%d;`, strings.Join(conds, " AND "), len(args))
+
+	rows, err := db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*QuestionMatch
+
+	for rows.Next() {
+		match := &QuestionMatch{}
+
+		question, err := scanQuestion(rows, &match.Rank, &match.Snippet)
+		if err != nil {
+			return nil, err
+		}
+		match.Question = question
+
+		list = append(list, match)
+	}
+
+	return list, rows.Err()
+}
+
+func searchLimit(query *QuestionQuery) int {
+	limit := int(query.Limit)
+	if limit <= 0 {
+		return defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		return maxSearchLimit
+	}
+	return limit
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"encoding/hex"
+	"net"
+	"time"
 
 	// external packages
 	"github.com/getsentry/sentry-go"
@@ -17,10 +19,34 @@ import (
 )
 
 const (
-	DBSession string = "dbSession"
-	tpKey     string = "traceparent"
+	DBSession    string = "dbSession"
+	principalKey string = "principal"
+	tpKey        string = "traceparent"
 )
 
+type Config struct {
+	EditWindow       time.Duration
+	AuthSecret       []byte
+	Moderators       []string
+	ScheduleInterval time.Duration
+	PriceFeed        PriceFeedConfig
+	InitialCash      float64
+
+	CorporateActionInterval time.Duration
+	Calendar                *MarketCalendar
+	BoardCache              Cache
+	RateLimiter             RateLimiter
+	RateLimits              map[string]RateLimit
+	TrustedProxies          []*net.IPNet
+
+	Replica               *sql.DB
+	ReplicaWindow         time.Duration
+	ReplicaHealthInterval time.Duration
+
+	PurgeInterval  time.Duration
+	PurgeRetention time.Duration
+}
+
 func SentryStreamInterceptor() grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		ctx := ss.Context()
@@ -29,11 +55,13 @@ func SentryStreamInterceptor() grpc.StreamServerInterceptor {
 			hub = sentry.CurrentHub().Clone()
 			ctx = sentry.SetHubOnContext(ctx, hub)
 		}
+		hub.Scope().SetTag("request_id", requestIdFromContext(ctx))
 
 		span := sentry.StartTransaction(ctx, info.FullMethod, func(s *sentry.Span) {
 			s.Name = "finpc-server"
 			s.Op = "grpc.server"
 			s.Description = info.FullMethod
+			s.SetTag("request_id", requestIdFromContext(ctx))
 
 			traceId := metadata.ValueFromIncomingContext(ctx, "traceid")
 			if traceId != nil && len(traceId) != 0 {
@@ -53,7 +81,7 @@ func SentryStreamInterceptor() grpc.StreamServerInterceptor {
 
 			traceParent := metadata.ValueFromIncomingContext(ctx, tpKey)
 			if traceParent != nil && len(traceParent) != 0 {
-				log.Info("[gRPC][Metadata] ", tpKey, ": ", traceParent[0])
+				log.WithField(tpKey, traceParent[0]).Debug("gRPC metadata")
 			}
 		})
 
@@ -79,11 +107,13 @@ func SentryUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 			hub = sentry.CurrentHub().Clone()
 			ctx = sentry.SetHubOnContext(ctx, hub)
 		}
+		hub.Scope().SetTag("request_id", requestIdFromContext(ctx))
 
 		span := sentry.StartTransaction(ctx, info.FullMethod, func(s *sentry.Span) {
 			s.Name = "finpc-server"
 			s.Op = "grpc.server"
 			s.Description = info.FullMethod
+			s.SetTag("request_id", requestIdFromContext(ctx))
 
 			traceId := metadata.ValueFromIncomingContext(ctx, "traceid")
 			if traceId != nil && len(traceId) != 0 {
@@ -103,7 +133,7 @@ func SentryUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 
 			traceParent := metadata.ValueFromIncomingContext(ctx, tpKey)
 			if traceParent != nil && len(traceParent) != 0 {
-				log.Info("[gRPC][Metadata] ", tpKey, ": ", traceParent[0])
+				log.WithField(tpKey, traceParent[0]).Debug("gRPC metadata")
 			}
 		})
 
@@ -114,7 +144,6 @@ func SentryUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 
 		resp, err := handler(ctx, req)
 		if err != nil {
-			log.Error(err)
 			span.Status = toSentrySpanStatus(err)
 		}
 
@@ -128,23 +157,104 @@ func DBUnaryServerInterceptor(session *sql.DB) grpc.UnaryServerInterceptor {
 	}
 }
 
-func NewGrpcServer(db *sql.DB) *grpc.Server {
+func DBStreamServerInterceptor(session *sql.DB) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		stream := grpc_middleware.WrapServerStream(ss)
+		stream.WrappedContext = context.WithValue(ss.Context(), DBSession, session)
+		return handler(srv, stream)
+	}
+}
+
+func NewGrpcServer(db *sql.DB, config Config, candles *CandleAggregator, likes *LikeBuffer) (*grpc.Server, error) {
 
 	creds := insecure.NewCredentials()
 	grpcServer := grpc.NewServer(
 		grpc.Creds(creds),
 		grpc.ChainStreamInterceptor(
+			RequestIdStreamServerInterceptor(),
 			SentryStreamInterceptor(),
+			LogStreamServerInterceptor(),
+			DBStreamServerInterceptor(db),
+			ClientAddrStreamServerInterceptor(config.TrustedProxies),
+			AuthStreamServerInterceptor(config.AuthSecret, config.Moderators),
+			RateLimitStreamServerInterceptor(config.RateLimiter, config.RateLimits),
 		),
 		grpc.ChainUnaryInterceptor(
+			RequestIdUnaryServerInterceptor(),
 			SentryUnaryServerInterceptor(),
+			LogUnaryServerInterceptor(),
 			DBUnaryServerInterceptor(db),
+			ClientAddrUnaryServerInterceptor(config.TrustedProxies),
+			AuthUnaryServerInterceptor(config.AuthSecret, config.Moderators),
+			RateLimitUnaryServerInterceptor(config.RateLimiter, config.RateLimits),
 		),
 	)
 
-	RegisterBoardServer(grpcServer, &Board{})
+	stmts, err := prepareBoardStatements(db, false)
+	if err != nil {
+		return nil, err
+	}
+
+	board := &Board{
+		editWindow: config.EditWindow,
+		events:     newBroker[*SubjectEvent](),
+		likes:      likes,
+		stmts:      stmts,
+		searcher:   &postgresSearcher{db: db},
+	}
+	if config.Replica != nil {
+		board.replica = newReplicaRouter(config.Replica, stmts, config.ReplicaWindow)
+		go board.replica.run(config.ReplicaHealthInterval)
+	}
+	if config.BoardCache != nil {
+		board.cache = newBoardCache(config.BoardCache)
+	}
+	if likes != nil {
+		likes.Listen(board.likesFlushed)
+	}
+	if config.ScheduleInterval > 0 {
+		go board.runScheduler(db, config.ScheduleInterval)
+	}
+	if config.PurgeInterval > 0 {
+		go board.runPurge(db, config.PurgeInterval, config.PurgeRetention)
+	}
+
+	RegisterBoardServer(grpcServer, board)
+	feed := NewPriceFeed(db, config.PriceFeed, config.Calendar)
+	feed.Listen(candles.add)
+	if config.PriceFeed.Interval > 0 {
+		go feed.Run()
+	}
+
+	trading, err := NewTrading(db, feed, config.Calendar, config.InitialCash)
+	if err != nil {
+		return nil, err
+	}
+	if config.Calendar != nil {
+		go trading.runSessions()
+	}
+
+	stocks := &Stocks{
+		feed:       feed,
+		candles:    candles,
+		trading:    trading,
+		watchlists: newBroker[*Watchlist](),
+	}
+	if config.CorporateActionInterval > 0 {
+		go stocks.runCorporateActions(db, config.CorporateActionInterval)
+	}
+
+	alerts, err := NewAlerts(db)
+	if err != nil {
+		return nil, err
+	}
+	feed.Listen(alerts.evaluate)
+
+	RegisterStocksServer(grpcServer, stocks)
+	RegisterAlertsServer(grpcServer, alerts)
+	RegisterTradingServer(grpcServer, trading)
 
-	return grpcServer
+	return grpcServer, nil
 }
 
 func toSentrySpanStatus(err error) sentry.SpanStatus {
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	selectQuestionsQuery = `
+SELECT q.id, q.question, q.likes, q.author_id, q.created_at, q.updated_at, q.answered,
+       COALESCE(array_agg(t.name ORDER BY t.name) FILTER (WHERE t.id IS NOT NULL), '{}')
+  FROM question q
+  LEFT JOIN question_tag qt ON qt.question_id = q.id
+  LEFT JOIN tag t ON t.id = qt.tag_id
+ WHERE q.subject_id = (SELECT id FROM subject WHERE id = $1 AND deleted_at IS NULL)
+   AND q.deleted_at IS NULL
+ GROUP BY q.id`
+	matchAnyTags    = "\nHAVING bool_or(t.name = ANY($2))"
+	matchAllTags    = "\nHAVING count(DISTINCT t.name) FILTER (WHERE t.name = ANY($2)) = cardinality($2::text[])"
+	orderQuestions  = "\n ORDER BY q.likes DESC, q.question ASC;"
+	questionColumns = "id, question, likes, author_id, created_at, updated_at, answered"
+)
+
+// boardStatements holds the queries of the Board hot paths, prepared once
+// at startup instead of being parsed and planned again on every call. The
+// statements of a read replica leave the writes unprepared.
+type boardStatements struct {
+	db *sql.DB
+
+	selectSubject           *sql.Stmt
+	selectSubjects          *sql.Stmt
+	selectQuestionSubject   *sql.Stmt
+	selectQuestions         *sql.Stmt
+	selectQuestionsAnyTag   *sql.Stmt
+	selectQuestionsAllTags  *sql.Stmt
+	selectQuestionForUpdate *sql.Stmt
+	selectQuestionLikes     *sql.Stmt
+	insertQuestion          *sql.Stmt
+	insertQuestionEdit      *sql.Stmt
+	updateQuestionLikes     *sql.Stmt
+}
+
+func prepareBoardStatements(db *sql.DB, readOnly bool) (*boardStatements, error) {
+	s := &boardStatements{db: db}
+
+	statements := []struct {
+		stmt  **sql.Stmt
+		query string
+		write bool
+	}{
+		{&s.selectSubject, "SELECT " + subjectColumns + " FROM subject WHERE id = $1 AND deleted_at IS NULL", false},
+		{&s.selectSubjects, "SELECT " + subjectColumns + " FROM subject WHERE deleted_at IS NULL ORDER BY id;", false},
+		{&s.selectQuestionSubject, "SELECT " + subjectColumns + " FROM subject WHERE id = (SELECT subject_id FROM question WHERE id = $1 AND deleted_at IS NULL) AND deleted_at IS NULL", false},
+		{&s.selectQuestions, selectQuestionsQuery + orderQuestions, false},
+		{&s.selectQuestionsAnyTag, selectQuestionsQuery + matchAnyTags + orderQuestions, false},
+		{&s.selectQuestionsAllTags, selectQuestionsQuery + matchAllTags + orderQuestions, false},
+		{&s.selectQuestionForUpdate, "SELECT " + questionColumns + " FROM question WHERE id = $1 AND deleted_at IS NULL FOR UPDATE", true},
+		{&s.selectQuestionLikes, "SELECT likes FROM question WHERE id = $1", false},
+		{&s.insertQuestion, "INSERT INTO question(question, subject_id, author_id) VALUES ($1, $2, $3) RETURNING " + questionColumns, true},
+		{&s.insertQuestionEdit, "INSERT INTO question_edit(question_id, action, question, editor_id) VALUES ($1, $2, $3, $4)", true},
+		{&s.updateQuestionLikes, updateQuestionLikesQuery, true},
+	}
+
+	for _, st := range statements {
+		if readOnly && st.write {
+			continue
+		}
+		stmt, err := db.Prepare(st.query)
+		if err != nil {
+			s.Close()
+			return nil, fmt.Errorf("failed to prepare %q: %w", st.query, err)
+		}
+		*st.stmt = stmt
+	}
+
+	return s, nil
+}
+
+func (s *boardStatements) Close() error {
+	var first error
+	for _, stmt := range []*sql.Stmt{
+		s.selectSubject, s.selectSubjects, s.selectQuestionSubject,
+		s.selectQuestions, s.selectQuestionsAnyTag, s.selectQuestionsAllTags,
+		s.selectQuestionForUpdate, s.selectQuestionLikes,
+		s.insertQuestion, s.insertQuestionEdit, s.updateQuestionLikes,
+	} {
+		if stmt == nil {
+			continue
+		}
+		if err := stmt.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
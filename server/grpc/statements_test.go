@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	// external packages
+	"github.com/lib/pq"
+)
+
+// BenchmarkBoardStatements compares the statements prepared once at startup
+// with preparing them again on every call, as the helpers used to, on the
+// idle pool of database/sql and on one as large as the open connections.
+func BenchmarkBoardStatements(b *testing.B) {
+	db := openTestDB(b)
+	subjectId, questionId := insertTestQuestion(b, db, 0)
+
+	stmts, err := prepareBoardStatements(db, false)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer stmts.Close()
+
+	benchmarks := []struct {
+		name  string
+		stmt  *sql.Stmt
+		query string
+		args  []interface{}
+	}{
+		{
+			name:  "selectSubject",
+			stmt:  stmts.selectSubject,
+			query: "SELECT " + subjectColumns + " FROM subject WHERE id = $1 AND deleted_at IS NULL",
+			args:  []interface{}{subjectId},
+		},
+		{
+			name:  "selectQuestions",
+			stmt:  stmts.selectQuestions,
+			query: selectQuestionsQuery + orderQuestions,
+			args:  []interface{}{subjectId},
+		},
+		{
+			name:  "selectQuestionsAllTags",
+			stmt:  stmts.selectQuestionsAllTags,
+			query: selectQuestionsQuery + matchAllTags + orderQuestions,
+			args:  []interface{}{subjectId, pq.Array([]string{"a", "b"})},
+		},
+		{
+			name:  "updateQuestionLikes",
+			stmt:  stmts.updateQuestionLikes,
+			query: updateQuestionLikesQuery,
+			args:  []interface{}{questionId, 1},
+		},
+	}
+
+	for _, bm := range benchmarks {
+		for _, idle := range []int{2, 20} {
+			b.Run(fmt.Sprintf("%s/idle=%d/prepared", bm.name, idle), func(b *testing.B) {
+				db.SetMaxIdleConns(idle)
+				b.RunParallel(func(pb *testing.PB) {
+					for pb.Next() {
+						if err := drainRows(bm.stmt.Query(bm.args...)); err != nil {
+							b.Error(err)
+							return
+						}
+					}
+				})
+			})
+
+			b.Run(fmt.Sprintf("%s/idle=%d/per-call", bm.name, idle), func(b *testing.B) {
+				db.SetMaxIdleConns(idle)
+				b.RunParallel(func(pb *testing.PB) {
+					for pb.Next() {
+						stmt, err := db.Prepare(bm.query)
+						if err != nil {
+							b.Error(err)
+							return
+						}
+						err = drainRows(stmt.Query(bm.args...))
+						stmt.Close()
+						if err != nil {
+							b.Error(err)
+							return
+						}
+					}
+				})
+			})
+		}
+	}
+}
+
+func drainRows(rows *sql.Rows, err error) error {
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+	}
+	return rows.Err()
+}
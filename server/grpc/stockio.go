@@ -0,0 +1,337 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	StockFormatCSV  = "csv"
+	StockFormatJSON = "json"
+)
+
+var (
+	stockCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	stockCSVHeader   = []string{"code", "name", "total_stock_count"}
+)
+
+type stockRecord struct {
+	Code            string `json:"code"`
+	Name            string `json:"name"`
+	TotalStockCount int64  `json:"total_stock_count"`
+}
+
+type stockRow struct {
+	stockRecord
+	row int32
+}
+
+func (s *Stocks) ImportStocks(ctx context.Context, request *StockImport) (*StockImportResult, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/ImportStocks")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	if !principalFromContext(ctx).Moderator {
+		loggerFromContext(ctx).Errorf("ImportStocks: permission denied")
+		return nil, status.Error(codes.PermissionDenied, "only a moderator can import stocks")
+	}
+
+	result, err := ImportStockData(ctx, db, request.Format, request.Data)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ImportStocks")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *Stocks) ExportStocks(ctx context.Context, request *StockExportRequest) (*StockExport, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/ExportStocks")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	data, err := ExportStockData(ctx, db, request.Format)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ExportStocks")
+		return nil, err
+	}
+
+	return &StockExport{
+		Format: strings.ToLower(request.Format),
+		Data:   data,
+	}, nil
+}
+
+// ImportStockData upserts stocks by code. Rows that fail validation are
+// reported in the result and skipped; the other rows are still imported.
+func ImportStockData(ctx context.Context, db *sql.DB, format string, data []byte) (*StockImportResult, error) {
+	rows, rowErrors, err := decodeStocks(format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, errs := validateStocks(rows)
+	rowErrors = append(rowErrors, errs...)
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, errs, err = checkStockNames(ctx, tx, rows)
+	if err != nil {
+		return nil, err
+	}
+	rowErrors = append(rowErrors, errs...)
+
+	if err := upsertStocks(ctx, tx, rows); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(rowErrors, func(i, j int) bool { return rowErrors[i].Row < rowErrors[j].Row })
+
+	return &StockImportResult{
+		Imported: int32(len(rows)),
+		Errors:   rowErrors,
+	}, nil
+}
+
+func ExportStockData(ctx context.Context, db *sql.DB, format string) ([]byte, error) {
+	format = strings.ToLower(format)
+	if format != StockFormatCSV && format != StockFormatJSON {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown format '%s'", format)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT code, name, total_stock_count FROM stocks ORDER BY code;")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := []stockRecord{}
+	for rows.Next() {
+		var r stockRecord
+		if err := rows.Scan(&r.Code, &r.Name, &r.TotalStockCount); err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if format == StockFormatJSON {
+		return json.MarshalIndent(records, "", "  ")
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(stockCSVHeader)
+	for _, r := range records {
+		w.Write([]string{r.Code, r.Name, strconv.FormatInt(r.TotalStockCount, 10)})
+	}
+	w.Flush()
+
+	return buf.Bytes(), w.Error()
+}
+
+func decodeStocks(format string, data []byte) ([]stockRow, []*StockImportError, error) {
+	switch strings.ToLower(format) {
+	case StockFormatJSON:
+		var records []stockRecord
+		if err := json.Unmarshal(data, &records); err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid json: %s", err)
+		}
+		rows := make([]stockRow, 0, len(records))
+		for i, r := range records {
+			rows = append(rows, stockRow{stockRecord: r, row: int32(i + 1)})
+		}
+		return rows, nil, nil
+	case StockFormatCSV:
+		return decodeStocksCSV(data)
+	default:
+		return nil, nil, status.Errorf(codes.InvalidArgument, "unknown format '%s'", format)
+	}
+}
+
+func decodeStocksCSV(data []byte) ([]stockRow, []*StockImportError, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid csv header: %s", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, h := range stockCSVHeader {
+		if _, ok := columns[h]; !ok {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "csv header has no '%s' column", h)
+		}
+	}
+
+	var rows []stockRow
+	var rowErrors []*StockImportError
+
+	for n := int32(1); ; n++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "invalid csv: %s", err)
+		}
+
+		row := stockRow{
+			stockRecord: stockRecord{
+				Code: record[columns["code"]],
+				Name: record[columns["name"]],
+			},
+			row: n,
+		}
+		count := strings.TrimSpace(record[columns["total_stock_count"]])
+		if row.TotalStockCount, err = strconv.ParseInt(count, 10, 64); err != nil {
+			rowErrors = append(rowErrors, &StockImportError{
+				Row:     n,
+				Code:    row.Code,
+				Message: fmt.Sprintf("invalid total_stock_count '%s'", count),
+			})
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, rowErrors, nil
+}
+
+func validateStocks(rows []stockRow) ([]stockRow, []*StockImportError) {
+	var valid []stockRow
+	var rowErrors []*StockImportError
+
+	codeRows := make(map[string]int32)
+	nameRows := make(map[string]int32)
+
+	for _, row := range rows {
+		row.Code = strings.ToUpper(strings.TrimSpace(row.Code))
+		row.Name = strings.TrimSpace(row.Name)
+
+		var msg string
+		switch {
+		case !stockCodePattern.MatchString(row.Code):
+			msg = "code must be 3 letters"
+		case row.Name == "":
+			msg = "name is empty"
+		case row.TotalStockCount < 0:
+			msg = "total_stock_count is negative"
+		case row.TotalStockCount > math.MaxInt32:
+			msg = "total_stock_count is too large"
+		case codeRows[row.Code] != 0:
+			msg = fmt.Sprintf("code is duplicated with row %d", codeRows[row.Code])
+		case nameRows[row.Name] != 0:
+			msg = fmt.Sprintf("name is duplicated with row %d", nameRows[row.Name])
+		}
+		if msg != "" {
+			rowErrors = append(rowErrors, &StockImportError{Row: row.row, Code: row.Code, Message: msg})
+			continue
+		}
+
+		codeRows[row.Code] = row.row
+		nameRows[row.Name] = row.row
+		valid = append(valid, row)
+	}
+
+	return valid, rowErrors
+}
+
+func checkStockNames(ctx context.Context, tx *sql.Tx, rows []stockRow) ([]stockRow, []*StockImportError, error) {
+	if len(rows) == 0 {
+		return rows, nil, nil
+	}
+
+	names := make([]string, 0, len(rows))
+	for _, row := range rows {
+		names = append(names, row.Name)
+	}
+
+	result, err := tx.QueryContext(ctx, "SELECT code, name FROM stocks WHERE name = ANY($1) FOR UPDATE;", pq.Array(names))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer result.Close()
+
+	owners := make(map[string]string)
+	for result.Next() {
+		var code, name string
+		if err := result.Scan(&code, &name); err != nil {
+			return nil, nil, err
+		}
+		owners[name] = code
+	}
+	if err := result.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	var valid []stockRow
+	var rowErrors []*StockImportError
+
+	for _, row := range rows {
+		if code, ok := owners[row.Name]; ok && code != row.Code {
+			rowErrors = append(rowErrors, &StockImportError{
+				Row:     row.row,
+				Code:    row.Code,
+				Message: fmt.Sprintf("name is already used by '%s'", code),
+			})
+			continue
+		}
+		valid = append(valid, row)
+	}
+
+	return valid, rowErrors, nil
+}
+
+func upsertStocks(ctx context.Context, tx *sql.Tx, rows []stockRow) error {
+	stmt, err := tx.PrepareContext(ctx, `
+INSERT INTO stocks(code, name, total_stock_count)
+VALUES ($1, $2, $3)
+ON CONFLICT (code) DO UPDATE
+   SET name              = EXCLUDED.name,
+       total_stock_count = EXCLUDED.total_stock_count`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, row := range rows {
+		if _, err := stmt.ExecContext(ctx, row.Code, row.Name, row.TotalStockCount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
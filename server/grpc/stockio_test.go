@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"reflect"
+	"testing"
+
+	// external packages
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDecodeStocksCSV(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		rows   []stockRow
+		errors []*StockImportError
+		code   codes.Code
+	}{
+		{
+			name: "rows",
+			data: "code,name,total_stock_count\nABC,Alpha,100\nDEF, Delta ,200\n",
+			rows: []stockRow{
+				{stockRecord{"ABC", "Alpha", 100}, 1},
+				{stockRecord{"DEF", "Delta ", 200}, 2},
+			},
+		},
+		{
+			name: "columns in any order",
+			data: "Total_Stock_Count, NAME ,code\n100,Alpha,ABC\n",
+			rows: []stockRow{{stockRecord{"ABC", "Alpha", 100}, 1}},
+		},
+		{
+			name:   "invalid count",
+			data:   "code,name,total_stock_count\nABC,Alpha,many\nDEF,Delta,200\n",
+			rows:   []stockRow{{stockRecord{"DEF", "Delta", 200}, 2}},
+			errors: []*StockImportError{{Row: 1, Code: "ABC", Message: "invalid total_stock_count 'many'"}},
+		},
+		{
+			name: "header only",
+			data: "code,name,total_stock_count\n",
+		},
+		{name: "empty", data: "", code: codes.InvalidArgument},
+		{name: "missing column", data: "code,name\nABC,Alpha\n", code: codes.InvalidArgument},
+		{name: "ragged row", data: "code,name,total_stock_count\nABC,Alpha\n", code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, rowErrors, err := decodeStocksCSV([]byte(tt.data))
+			if status.Code(err) != tt.code {
+				t.Fatalf("err = %v, want %v", err, tt.code)
+			}
+			if !reflect.DeepEqual(rows, tt.rows) {
+				t.Fatalf("rows = %v, want %v", rows, tt.rows)
+			}
+			if !reflect.DeepEqual(rowErrors, tt.errors) {
+				t.Fatalf("errors = %v, want %v", rowErrors, tt.errors)
+			}
+		})
+	}
+}
+
+func TestValidateStocks(t *testing.T) {
+	tests := []struct {
+		name   string
+		rows   []stockRow
+		valid  []stockRow
+		errors []*StockImportError
+	}{
+		{
+			name:  "normalized",
+			rows:  []stockRow{{stockRecord{" abc ", " Alpha ", 100}, 1}},
+			valid: []stockRow{{stockRecord{"ABC", "Alpha", 100}, 1}},
+		},
+		{
+			name:   "invalid code",
+			rows:   []stockRow{{stockRecord{"AB1", "Alpha", 100}, 1}, {stockRecord{"ABCD", "Beta", 100}, 2}},
+			errors: []*StockImportError{{Row: 1, Code: "AB1", Message: "code must be 3 letters"}, {Row: 2, Code: "ABCD", Message: "code must be 3 letters"}},
+		},
+		{
+			name:   "empty name",
+			rows:   []stockRow{{stockRecord{"ABC", "  ", 100}, 1}},
+			errors: []*StockImportError{{Row: 1, Code: "ABC", Message: "name is empty"}},
+		},
+		{
+			name:   "count out of range",
+			rows:   []stockRow{{stockRecord{"ABC", "Alpha", -1}, 1}, {stockRecord{"DEF", "Delta", 1 << 31}, 2}},
+			errors: []*StockImportError{{Row: 1, Code: "ABC", Message: "total_stock_count is negative"}, {Row: 2, Code: "DEF", Message: "total_stock_count is too large"}},
+		},
+		{
+			name:   "duplicated code",
+			rows:   []stockRow{{stockRecord{"ABC", "Alpha", 100}, 1}, {stockRecord{"abc", "Beta", 100}, 2}},
+			valid:  []stockRow{{stockRecord{"ABC", "Alpha", 100}, 1}},
+			errors: []*StockImportError{{Row: 2, Code: "ABC", Message: "code is duplicated with row 1"}},
+		},
+		{
+			name:   "duplicated name",
+			rows:   []stockRow{{stockRecord{"ABC", "Alpha", 100}, 1}, {stockRecord{"DEF", "Alpha ", 100}, 2}},
+			valid:  []stockRow{{stockRecord{"ABC", "Alpha", 100}, 1}},
+			errors: []*StockImportError{{Row: 2, Code: "DEF", Message: "name is duplicated with row 1"}},
+		},
+		{
+			name:   "invalid row does not claim its code",
+			rows:   []stockRow{{stockRecord{"ABC", "", 100}, 1}, {stockRecord{"ABC", "Alpha", 100}, 2}},
+			valid:  []stockRow{{stockRecord{"ABC", "Alpha", 100}, 2}},
+			errors: []*StockImportError{{Row: 1, Code: "ABC", Message: "name is empty"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid, rowErrors := validateStocks(tt.rows)
+			if !reflect.DeepEqual(valid, tt.valid) {
+				t.Fatalf("valid = %v, want %v", valid, tt.valid)
+			}
+			if !reflect.DeepEqual(rowErrors, tt.errors) {
+				t.Fatalf("errors = %v, want %v", rowErrors, tt.errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	stockColumns         = "id, code, name, total_stock_count"
+	defaultStockPageSize = 50
+	maxStockPageSize     = 500
+)
+
+type Stocks struct {
+	StocksServer
+
+	feed    *PriceFeed
+	candles *CandleAggregator
+	trading *Trading
+
+	watchlists *broker[*Watchlist]
+}
+
+func (s *Stocks) ListStocks(ctx context.Context, filter *StockFilter) (*StockList, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/ListStocks")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	afterId, err := decodePageToken(filter.PageToken)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ListStocks")
+		return nil, status.Error(codes.InvalidArgument, "invalid 'page_token'")
+	}
+
+	pageSize := int(filter.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultStockPageSize
+	}
+	if pageSize > maxStockPageSize {
+		pageSize = maxStockPageSize
+	}
+
+	list, err := selectStocks(ctx, db, filter, afterId, pageSize+1)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ListStocks")
+		return nil, err
+	}
+
+	var next string
+	if len(list) > pageSize {
+		list = list[:pageSize]
+		next = encodePageToken(list[pageSize-1].Id)
+	}
+
+	return &StockList{
+		StockList:     list,
+		NextPageToken: next,
+	}, nil
+}
+
+func (s *Stocks) GetStockByCode(ctx context.Context, stockCode *StockCode) (*Stock, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/GetStockByCode")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	stock, err := selectStock(ctx, db, "code", strings.ToUpper(stockCode.Code))
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetStockByCode")
+		return nil, err
+	}
+
+	return stock, nil
+}
+
+func (s *Stocks) GetStockById(ctx context.Context, stockId *StockId) (*Stock, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/GetStockById")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	stock, err := selectStock(ctx, db, "id", stockId.Id)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetStockById")
+		return nil, err
+	}
+
+	return stock, nil
+}
+
+func (s *Stocks) StreamQuotes(request *QuoteRequest, stream Stocks_StreamQuotesServer) error {
+	quotes := s.feed.quotes.subscribe()
+	defer s.feed.quotes.unsubscribe(quotes)
+
+	for _, q := range s.feed.Snapshot(request.Codes) {
+		if err := stream.Send(q); err != nil {
+			loggerFromContext(stream.Context()).WithError(err).Error("StreamQuotes")
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case q := <-quotes:
+			if len(request.Codes) != 0 && !containsCode(request.Codes, q.Code) {
+				continue
+			}
+			if err := stream.Send(q); err != nil {
+				loggerFromContext(stream.Context()).WithError(err).Error("StreamQuotes")
+				return err
+			}
+		}
+	}
+}
+
+func selectStocks(ctx context.Context, db *sql.DB, filter *StockFilter, afterId int64, limit int) ([]*Stock, error) {
+	conds := []string{"id > $1"}
+	args := []interface{}{afterId}
+
+	if q := strings.TrimSpace(filter.Query); q != "" {
+		args = append(args, "%"+q+"%")
+		conds = append(conds, fmt.Sprintf("(code ILIKE $%d OR name ILIKE $%d)", len(args), len(args)))
+	}
+	if len(filter.Codes) != 0 {
+		upper := make([]string, 0, len(filter.Codes))
+		for _, c := range filter.Codes {
+			upper = append(upper, strings.ToUpper(c))
+		}
+		args = append(args, pq.Array(upper))
+		conds = append(conds, fmt.Sprintf("code = ANY($%d)", len(args)))
+	}
+	args = append(args, limit)
+
+	stmt := fmt.Sprintf("SELECT %s FROM stocks WHERE %s ORDER BY id LIMIT $%d;",
+		stockColumns, strings.Join(conds, " AND "), len(args))
+
+	rows, err := db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Stock
+
+	for rows.Next() {
+		stock := &Stock{}
+		if err := rows.Scan(&stock.Id, &stock.Code, &stock.Name, &stock.TotalStockCount); err != nil {
+			return nil, err
+		}
+		list = append(list, stock)
+	}
+
+	return list, rows.Err()
+}
+
+func selectStock(ctx context.Context, db *sql.DB, column string, value interface{}) (*Stock, error) {
+	stock := &Stock{}
+
+	err := db.QueryRowContext(ctx,
+		"SELECT "+stockColumns+" FROM stocks WHERE "+column+" = $1", value).
+		Scan(&stock.Id, &stock.Code, &stock.Name, &stock.TotalStockCount)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "stock '%v' is not exists", value)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return stock, nil
+}
+
+func encodePageToken(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(b), 10, 64)
+}
@@ -0,0 +1,747 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.23.4
+// source: stocks.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StocksClient is the client API for Stocks service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StocksClient interface {
+	ListStocks(ctx context.Context, in *StockFilter, opts ...grpc.CallOption) (*StockList, error)
+	GetStockByCode(ctx context.Context, in *StockCode, opts ...grpc.CallOption) (*Stock, error)
+	GetStockById(ctx context.Context, in *StockId, opts ...grpc.CallOption) (*Stock, error)
+	StreamQuotes(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (Stocks_StreamQuotesClient, error)
+	GetCandles(ctx context.Context, in *CandleRequest, opts ...grpc.CallOption) (*CandleList, error)
+	RebuildCandles(ctx context.Context, in *CandleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ImportStocks(ctx context.Context, in *StockImport, opts ...grpc.CallOption) (*StockImportResult, error)
+	ExportStocks(ctx context.Context, in *StockExportRequest, opts ...grpc.CallOption) (*StockExport, error)
+	// CreateCorporateAction schedules a share count change. When a split or a
+	// reverse split is applied, the quotes, candles and holdings of the stock
+	// are rescaled and its open orders are cancelled and sent on
+	// Trading.StreamOrders. Past orders and trades keep their original prices
+	// and quantities.
+	CreateCorporateAction(ctx context.Context, in *NewCorporateAction, opts ...grpc.CallOption) (*CorporateAction, error)
+	ListCorporateActions(ctx context.Context, in *StockCode, opts ...grpc.CallOption) (*CorporateActionList, error)
+	GetShareCount(ctx context.Context, in *ShareCountRequest, opts ...grpc.CallOption) (*ShareCount, error)
+	CreateWatchlist(ctx context.Context, in *NewWatchlist, opts ...grpc.CallOption) (*Watchlist, error)
+	ListWatchlists(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WatchlistList, error)
+	GetWatchlist(ctx context.Context, in *WatchlistId, opts ...grpc.CallOption) (*Watchlist, error)
+	UpdateWatchlist(ctx context.Context, in *WatchlistUpdate, opts ...grpc.CallOption) (*Watchlist, error)
+	DeleteWatchlist(ctx context.Context, in *WatchlistId, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StreamWatchlist(ctx context.Context, in *WatchlistId, opts ...grpc.CallOption) (Stocks_StreamWatchlistClient, error)
+}
+
+type stocksClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStocksClient(cc grpc.ClientConnInterface) StocksClient {
+	return &stocksClient{cc}
+}
+
+func (c *stocksClient) ListStocks(ctx context.Context, in *StockFilter, opts ...grpc.CallOption) (*StockList, error) {
+	out := new(StockList)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/ListStocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) GetStockByCode(ctx context.Context, in *StockCode, opts ...grpc.CallOption) (*Stock, error) {
+	out := new(Stock)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/GetStockByCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) GetStockById(ctx context.Context, in *StockId, opts ...grpc.CallOption) (*Stock, error) {
+	out := new(Stock)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/GetStockById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) StreamQuotes(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (Stocks_StreamQuotesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stocks_ServiceDesc.Streams[0], "/stocks.Stocks/StreamQuotes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stocksStreamQuotesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Stocks_StreamQuotesClient interface {
+	Recv() (*Quote, error)
+	grpc.ClientStream
+}
+
+type stocksStreamQuotesClient struct {
+	grpc.ClientStream
+}
+
+func (x *stocksStreamQuotesClient) Recv() (*Quote, error) {
+	m := new(Quote)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *stocksClient) GetCandles(ctx context.Context, in *CandleRequest, opts ...grpc.CallOption) (*CandleList, error) {
+	out := new(CandleList)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/GetCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) RebuildCandles(ctx context.Context, in *CandleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/RebuildCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) ImportStocks(ctx context.Context, in *StockImport, opts ...grpc.CallOption) (*StockImportResult, error) {
+	out := new(StockImportResult)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/ImportStocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) ExportStocks(ctx context.Context, in *StockExportRequest, opts ...grpc.CallOption) (*StockExport, error) {
+	out := new(StockExport)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/ExportStocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) CreateCorporateAction(ctx context.Context, in *NewCorporateAction, opts ...grpc.CallOption) (*CorporateAction, error) {
+	out := new(CorporateAction)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/CreateCorporateAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) ListCorporateActions(ctx context.Context, in *StockCode, opts ...grpc.CallOption) (*CorporateActionList, error) {
+	out := new(CorporateActionList)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/ListCorporateActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) GetShareCount(ctx context.Context, in *ShareCountRequest, opts ...grpc.CallOption) (*ShareCount, error) {
+	out := new(ShareCount)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/GetShareCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) CreateWatchlist(ctx context.Context, in *NewWatchlist, opts ...grpc.CallOption) (*Watchlist, error) {
+	out := new(Watchlist)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/CreateWatchlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) ListWatchlists(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WatchlistList, error) {
+	out := new(WatchlistList)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/ListWatchlists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) GetWatchlist(ctx context.Context, in *WatchlistId, opts ...grpc.CallOption) (*Watchlist, error) {
+	out := new(Watchlist)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/GetWatchlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) UpdateWatchlist(ctx context.Context, in *WatchlistUpdate, opts ...grpc.CallOption) (*Watchlist, error) {
+	out := new(Watchlist)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/UpdateWatchlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) DeleteWatchlist(ctx context.Context, in *WatchlistId, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/stocks.Stocks/DeleteWatchlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksClient) StreamWatchlist(ctx context.Context, in *WatchlistId, opts ...grpc.CallOption) (Stocks_StreamWatchlistClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Stocks_ServiceDesc.Streams[1], "/stocks.Stocks/StreamWatchlist", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stocksStreamWatchlistClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Stocks_StreamWatchlistClient interface {
+	Recv() (*Quote, error)
+	grpc.ClientStream
+}
+
+type stocksStreamWatchlistClient struct {
+	grpc.ClientStream
+}
+
+func (x *stocksStreamWatchlistClient) Recv() (*Quote, error) {
+	m := new(Quote)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StocksServer is the server API for Stocks service.
+// All implementations must embed UnimplementedStocksServer
+// for forward compatibility
+type StocksServer interface {
+	ListStocks(context.Context, *StockFilter) (*StockList, error)
+	GetStockByCode(context.Context, *StockCode) (*Stock, error)
+	GetStockById(context.Context, *StockId) (*Stock, error)
+	StreamQuotes(*QuoteRequest, Stocks_StreamQuotesServer) error
+	GetCandles(context.Context, *CandleRequest) (*CandleList, error)
+	RebuildCandles(context.Context, *CandleRequest) (*emptypb.Empty, error)
+	ImportStocks(context.Context, *StockImport) (*StockImportResult, error)
+	ExportStocks(context.Context, *StockExportRequest) (*StockExport, error)
+	// CreateCorporateAction schedules a share count change. When a split or a
+	// reverse split is applied, the quotes, candles and holdings of the stock
+	// are rescaled and its open orders are cancelled and sent on
+	// Trading.StreamOrders. Past orders and trades keep their original prices
+	// and quantities.
+	CreateCorporateAction(context.Context, *NewCorporateAction) (*CorporateAction, error)
+	ListCorporateActions(context.Context, *StockCode) (*CorporateActionList, error)
+	GetShareCount(context.Context, *ShareCountRequest) (*ShareCount, error)
+	CreateWatchlist(context.Context, *NewWatchlist) (*Watchlist, error)
+	ListWatchlists(context.Context, *emptypb.Empty) (*WatchlistList, error)
+	GetWatchlist(context.Context, *WatchlistId) (*Watchlist, error)
+	UpdateWatchlist(context.Context, *WatchlistUpdate) (*Watchlist, error)
+	DeleteWatchlist(context.Context, *WatchlistId) (*emptypb.Empty, error)
+	StreamWatchlist(*WatchlistId, Stocks_StreamWatchlistServer) error
+	mustEmbedUnimplementedStocksServer()
+}
+
+// UnimplementedStocksServer must be embedded to have forward compatible implementations.
+type UnimplementedStocksServer struct {
+}
+
+func (UnimplementedStocksServer) ListStocks(context.Context, *StockFilter) (*StockList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStocks not implemented")
+}
+func (UnimplementedStocksServer) GetStockByCode(context.Context, *StockCode) (*Stock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockByCode not implemented")
+}
+func (UnimplementedStocksServer) GetStockById(context.Context, *StockId) (*Stock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockById not implemented")
+}
+func (UnimplementedStocksServer) StreamQuotes(*QuoteRequest, Stocks_StreamQuotesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamQuotes not implemented")
+}
+func (UnimplementedStocksServer) GetCandles(context.Context, *CandleRequest) (*CandleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandles not implemented")
+}
+func (UnimplementedStocksServer) RebuildCandles(context.Context, *CandleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildCandles not implemented")
+}
+func (UnimplementedStocksServer) ImportStocks(context.Context, *StockImport) (*StockImportResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportStocks not implemented")
+}
+func (UnimplementedStocksServer) ExportStocks(context.Context, *StockExportRequest) (*StockExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportStocks not implemented")
+}
+func (UnimplementedStocksServer) CreateCorporateAction(context.Context, *NewCorporateAction) (*CorporateAction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCorporateAction not implemented")
+}
+func (UnimplementedStocksServer) ListCorporateActions(context.Context, *StockCode) (*CorporateActionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCorporateActions not implemented")
+}
+func (UnimplementedStocksServer) GetShareCount(context.Context, *ShareCountRequest) (*ShareCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShareCount not implemented")
+}
+func (UnimplementedStocksServer) CreateWatchlist(context.Context, *NewWatchlist) (*Watchlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWatchlist not implemented")
+}
+func (UnimplementedStocksServer) ListWatchlists(context.Context, *emptypb.Empty) (*WatchlistList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchlists not implemented")
+}
+func (UnimplementedStocksServer) GetWatchlist(context.Context, *WatchlistId) (*Watchlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatchlist not implemented")
+}
+func (UnimplementedStocksServer) UpdateWatchlist(context.Context, *WatchlistUpdate) (*Watchlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWatchlist not implemented")
+}
+func (UnimplementedStocksServer) DeleteWatchlist(context.Context, *WatchlistId) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWatchlist not implemented")
+}
+func (UnimplementedStocksServer) StreamWatchlist(*WatchlistId, Stocks_StreamWatchlistServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamWatchlist not implemented")
+}
+func (UnimplementedStocksServer) mustEmbedUnimplementedStocksServer() {}
+
+// UnsafeStocksServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StocksServer will
+// result in compilation errors.
+type UnsafeStocksServer interface {
+	mustEmbedUnimplementedStocksServer()
+}
+
+func RegisterStocksServer(s grpc.ServiceRegistrar, srv StocksServer) {
+	s.RegisterService(&Stocks_ServiceDesc, srv)
+}
+
+func _Stocks_ListStocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).ListStocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/ListStocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).ListStocks(ctx, req.(*StockFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_GetStockByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).GetStockByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/GetStockByCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).GetStockByCode(ctx, req.(*StockCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_GetStockById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).GetStockById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/GetStockById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).GetStockById(ctx, req.(*StockId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_StreamQuotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QuoteRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StocksServer).StreamQuotes(m, &stocksStreamQuotesServer{stream})
+}
+
+type Stocks_StreamQuotesServer interface {
+	Send(*Quote) error
+	grpc.ServerStream
+}
+
+type stocksStreamQuotesServer struct {
+	grpc.ServerStream
+}
+
+func (x *stocksStreamQuotesServer) Send(m *Quote) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Stocks_GetCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).GetCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/GetCandles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).GetCandles(ctx, req.(*CandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_RebuildCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).RebuildCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/RebuildCandles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).RebuildCandles(ctx, req.(*CandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_ImportStocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockImport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).ImportStocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/ImportStocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).ImportStocks(ctx, req.(*StockImport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_ExportStocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).ExportStocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/ExportStocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).ExportStocks(ctx, req.(*StockExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_CreateCorporateAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewCorporateAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).CreateCorporateAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/CreateCorporateAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).CreateCorporateAction(ctx, req.(*NewCorporateAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_ListCorporateActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockCode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).ListCorporateActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/ListCorporateActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).ListCorporateActions(ctx, req.(*StockCode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_GetShareCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).GetShareCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/GetShareCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).GetShareCount(ctx, req.(*ShareCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_CreateWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewWatchlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).CreateWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/CreateWatchlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).CreateWatchlist(ctx, req.(*NewWatchlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_ListWatchlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).ListWatchlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/ListWatchlists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).ListWatchlists(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_GetWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchlistId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).GetWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/GetWatchlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).GetWatchlist(ctx, req.(*WatchlistId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_UpdateWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchlistUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).UpdateWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/UpdateWatchlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).UpdateWatchlist(ctx, req.(*WatchlistUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_DeleteWatchlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchlistId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServer).DeleteWatchlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/stocks.Stocks/DeleteWatchlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServer).DeleteWatchlist(ctx, req.(*WatchlistId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Stocks_StreamWatchlist_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchlistId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StocksServer).StreamWatchlist(m, &stocksStreamWatchlistServer{stream})
+}
+
+type Stocks_StreamWatchlistServer interface {
+	Send(*Quote) error
+	grpc.ServerStream
+}
+
+type stocksStreamWatchlistServer struct {
+	grpc.ServerStream
+}
+
+func (x *stocksStreamWatchlistServer) Send(m *Quote) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Stocks_ServiceDesc is the grpc.ServiceDesc for Stocks service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Stocks_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "stocks.Stocks",
+	HandlerType: (*StocksServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListStocks",
+			Handler:    _Stocks_ListStocks_Handler,
+		},
+		{
+			MethodName: "GetStockByCode",
+			Handler:    _Stocks_GetStockByCode_Handler,
+		},
+		{
+			MethodName: "GetStockById",
+			Handler:    _Stocks_GetStockById_Handler,
+		},
+		{
+			MethodName: "GetCandles",
+			Handler:    _Stocks_GetCandles_Handler,
+		},
+		{
+			MethodName: "RebuildCandles",
+			Handler:    _Stocks_RebuildCandles_Handler,
+		},
+		{
+			MethodName: "ImportStocks",
+			Handler:    _Stocks_ImportStocks_Handler,
+		},
+		{
+			MethodName: "ExportStocks",
+			Handler:    _Stocks_ExportStocks_Handler,
+		},
+		{
+			MethodName: "CreateCorporateAction",
+			Handler:    _Stocks_CreateCorporateAction_Handler,
+		},
+		{
+			MethodName: "ListCorporateActions",
+			Handler:    _Stocks_ListCorporateActions_Handler,
+		},
+		{
+			MethodName: "GetShareCount",
+			Handler:    _Stocks_GetShareCount_Handler,
+		},
+		{
+			MethodName: "CreateWatchlist",
+			Handler:    _Stocks_CreateWatchlist_Handler,
+		},
+		{
+			MethodName: "ListWatchlists",
+			Handler:    _Stocks_ListWatchlists_Handler,
+		},
+		{
+			MethodName: "GetWatchlist",
+			Handler:    _Stocks_GetWatchlist_Handler,
+		},
+		{
+			MethodName: "UpdateWatchlist",
+			Handler:    _Stocks_UpdateWatchlist_Handler,
+		},
+		{
+			MethodName: "DeleteWatchlist",
+			Handler:    _Stocks_DeleteWatchlist_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamQuotes",
+			Handler:       _Stocks_StreamQuotes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamWatchlist",
+			Handler:       _Stocks_StreamWatchlist_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "stocks.proto",
+}
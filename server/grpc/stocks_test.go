@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPageToken(t *testing.T) {
+	for _, id := range []int64{0, 1, 9007199254740993} {
+		if got, err := decodePageToken(encodePageToken(id)); got != id || err != nil {
+			t.Fatalf("decodePageToken(encodePageToken(%d)) = %d, %v", id, got, err)
+		}
+	}
+	if got, err := decodePageToken(""); got != 0 || err != nil {
+		t.Fatalf("decodePageToken of no token = %d, %v, want the first page", got, err)
+	}
+	for _, token := range []string{"!!", encodePageToken(1) + "=", "YWJj"} {
+		if _, err := decodePageToken(token); err == nil {
+			t.Fatalf("decodePageToken(%q) = nil, want an error", token)
+		}
+	}
+}
+
+func TestListStocks(t *testing.T) {
+	db := openTestDB(t)
+	stocks := []*Stock{insertTestStock(t, db, 10), insertTestStock(t, db, 20), insertTestStock(t, db, 30)}
+
+	tx := sentry.StartTransaction(context.Background(), "test")
+	defer tx.Finish()
+	ctx := context.WithValue(tx.Context(), DBSession, db)
+	s := &Stocks{}
+
+	// every stock of this test, and only those, has its name in the name
+	query := t.Name()
+
+	var pages [][]string
+	filter := &StockFilter{Query: query, PageSize: 2}
+	for {
+		list, err := s.ListStocks(ctx, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var page []string
+		for _, stock := range list.StockList {
+			page = append(page, stock.Code)
+		}
+		pages = append(pages, page)
+		if list.NextPageToken == "" {
+			break
+		}
+		filter.PageToken = list.NextPageToken
+	}
+	want := [][]string{{stocks[0].Code, stocks[1].Code}, {stocks[2].Code}}
+	if !reflect.DeepEqual(pages, want) {
+		t.Fatalf("pages = %v, want %v", pages, want)
+	}
+
+	tests := []struct {
+		name   string
+		filter *StockFilter
+		want   []string
+	}{
+		{"query in any case", &StockFilter{Query: strings.ToLower(query)}, []string{stocks[0].Code, stocks[1].Code, stocks[2].Code}},
+		{"codes in any case", &StockFilter{Query: query, Codes: []string{strings.ToLower(stocks[0].Code), stocks[2].Code}}, []string{stocks[0].Code, stocks[2].Code}},
+		{"unknown code", &StockFilter{Query: query, Codes: []string{"???"}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := s.ListStocks(ctx, tt.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, stock := range list.StockList {
+				got = append(got, stock.Code)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("codes = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := s.ListStocks(ctx, &StockFilter{PageToken: "!!"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("ListStocks with a bad token = %v, want InvalidArgument", err)
+	}
+}
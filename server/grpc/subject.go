@@ -0,0 +1,498 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const subjectColumns = "id, title, enabled, opens_at, closes_at, closed_at, created_at, updated_at"
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func (b *Board) ScheduleSubject(ctx context.Context, schedule *SubjectSchedule) (*Subject, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/ScheduleSubject")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	if !principalFromContext(ctx).Moderator {
+		loggerFromContext(ctx).Errorf("ScheduleSubject: permission denied")
+		return nil, status.Error(codes.PermissionDenied, "only a moderator can schedule a subject")
+	}
+
+	opensAt := nullTime(schedule.OpensAt)
+	closesAt := nullTime(schedule.ClosesAt)
+	if opensAt.Valid && closesAt.Valid && !opensAt.Time.Before(closesAt.Time) {
+		loggerFromContext(ctx).Errorf("ScheduleSubject: opens_at is not before closes_at")
+		return nil, status.Error(codes.InvalidArgument, "'opens_at' must be before 'closes_at'")
+	}
+
+	subject, err := updateSubjectSchedule(ctx, db, schedule.Id, opensAt, closesAt)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ScheduleSubject")
+		return nil, err
+	}
+
+	b.subjectsChanged(ctx)
+
+	return subject, nil
+}
+
+func (b *Board) WatchSubjects(empty *emptypb.Empty, stream Board_WatchSubjectsServer) error {
+	events := b.events.subscribe()
+	defer b.events.unsubscribe(events)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				loggerFromContext(stream.Context()).WithError(err).Error("WatchSubjects")
+				return err
+			}
+		}
+	}
+}
+
+func (b *Board) CloseSubject(ctx context.Context, subjectId *SubjectId) (*Subject, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/CloseSubject")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	if !principalFromContext(ctx).Moderator {
+		loggerFromContext(ctx).Errorf("CloseSubject: permission denied")
+		return nil, status.Error(codes.PermissionDenied, "only a moderator can close a subject")
+	}
+
+	if err := b.flushLikes(); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CloseSubject")
+		return nil, err
+	}
+
+	subject, err := closeSubject(ctx, db, subjectId.Id)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CloseSubject")
+		return nil, err
+	}
+
+	b.subjectsChanged(ctx)
+
+	b.events.publish(&SubjectEvent{Type: SubjectEvent_CLOSED, Subject: subject, OccurredAt: subject.ClosedAt})
+
+	return subject, nil
+}
+
+func (b *Board) DeleteSubject(ctx context.Context, subjectId *SubjectId) (*emptypb.Empty, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/DeleteSubject")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	if !principalFromContext(ctx).Moderator {
+		loggerFromContext(ctx).Errorf("DeleteSubject: permission denied")
+		return nil, status.Error(codes.PermissionDenied, "only a moderator can delete a subject")
+	}
+
+	if err := b.flushLikes(); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("DeleteSubject")
+		return nil, err
+	}
+
+	if err := deleteSubject(ctx, db, subjectId.Id); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("DeleteSubject")
+		return nil, err
+	}
+
+	b.questionsChanged(ctx, subjectId.Id)
+	b.subjectsChanged(ctx)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (b *Board) RestoreSubject(ctx context.Context, subjectId *SubjectId) (*Subject, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/RestoreSubject")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	if !principalFromContext(ctx).Moderator {
+		loggerFromContext(ctx).Errorf("RestoreSubject: permission denied")
+		return nil, status.Error(codes.PermissionDenied, "only a moderator can restore a subject")
+	}
+
+	subject, err := restoreSubject(ctx, db, subjectId.Id)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("RestoreSubject")
+		return nil, err
+	}
+
+	b.questionsChanged(ctx, subject.Id)
+	b.subjectsChanged(ctx)
+
+	return subject, nil
+}
+
+func (b *Board) GetFinalRanking(ctx context.Context, subjectId *SubjectId) (*Ranking, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/GetFinalRanking")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	subject, err := selectSubject(b.stmts, subjectId.Id)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetFinalRanking: failed to select subject")
+		return nil, err
+	}
+	if subject == nil {
+		loggerFromContext(ctx).Errorf("GetFinalRanking: subjectId '%d' is not exists", subjectId.Id)
+		return nil, status.Error(codes.NotFound, "this subject is not exists")
+	}
+	if subject.ClosedAt == nil {
+		loggerFromContext(ctx).Errorf("GetFinalRanking: subjectId '%d' is not closed", subjectId.Id)
+		return nil, status.Error(codes.FailedPrecondition, "this subject is not closed yet")
+	}
+
+	ranking, err := selectRanking(ctx, db, subject.Id)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetFinalRanking")
+		return nil, err
+	}
+
+	return &Ranking{
+		SubjectId: subject.Id,
+		ClosedAt:  subject.ClosedAt,
+		Ranking:   ranking,
+	}, nil
+}
+
+func (b *Board) runScheduler(db *sql.DB, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := b.flipSchedules(db); err != nil {
+			sentry.CaptureException(err)
+			log.Errorf("SubjectScheduler: %s", err)
+		}
+	}
+}
+
+func (b *Board) flipSchedules(db *sql.DB) error {
+	opened, err := updateSubjects(db, `
+UPDATE subject SET enabled = true, schedule_state = 'opened', updated_at = now()
+ WHERE schedule_state = '' AND closed_at IS NULL AND deleted_at IS NULL AND opens_at <= now() AND (closes_at IS NULL OR closes_at > now())
+RETURNING `+subjectColumns+`;`)
+	if err != nil {
+		return err
+	}
+
+	closed, err := updateSubjects(db, `
+UPDATE subject SET enabled = false, schedule_state = 'closed', updated_at = now()
+ WHERE schedule_state <> 'closed' AND closed_at IS NULL AND deleted_at IS NULL AND closes_at <= now()
+RETURNING `+subjectColumns+`;`)
+	if err != nil {
+		return err
+	}
+
+	if len(opened) != 0 || len(closed) != 0 {
+		b.subjectsChanged(context.Background())
+	}
+
+	now := timestamppb.Now()
+	for _, subject := range opened {
+		log.Infof("SubjectScheduler: subject '%d' opened", subject.Id)
+		b.events.publish(&SubjectEvent{Type: SubjectEvent_OPENED, Subject: subject, OccurredAt: now})
+	}
+	for _, subject := range closed {
+		log.Infof("SubjectScheduler: subject '%d' closed", subject.Id)
+		b.events.publish(&SubjectEvent{Type: SubjectEvent_CLOSED, Subject: subject, OccurredAt: now})
+	}
+
+	return nil
+}
+
+func checkSubjectOpen(subject *Subject, now time.Time) error {
+	if subject.ClosedAt != nil {
+		return status.Error(codes.FailedPrecondition, "this subject is closed and read-only")
+	}
+	if !subject.Enabled {
+		return status.Error(codes.FailedPrecondition, "this subject is disabled")
+	}
+	if subject.OpensAt != nil && now.Before(subject.OpensAt.AsTime()) {
+		return status.Error(codes.FailedPrecondition, "this subject is not open yet")
+	}
+	if subject.ClosesAt != nil && !now.Before(subject.ClosesAt.AsTime()) {
+		return status.Error(codes.FailedPrecondition, "this subject is already closed")
+	}
+	return nil
+}
+
+// checkQuestionNotClosed returns the subject of the question unless it is
+// missing or closed.
+func checkQuestionNotClosed(stmts *boardStatements, questionId int64) (*Subject, error) {
+	subject, err := selectQuestionSubject(stmts, questionId)
+	if err != nil {
+		return nil, err
+	}
+	if subject == nil {
+		return nil, status.Errorf(codes.NotFound, "question '%d' is not exists", questionId)
+	}
+	if subject.ClosedAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "this subject is closed and read-only")
+	}
+	return subject, nil
+}
+
+func checkQuestionOpen(stmts *boardStatements, questionId int64) error {
+	subject, err := selectQuestionSubject(stmts, questionId)
+	if err != nil {
+		return err
+	}
+	if subject == nil {
+		return status.Errorf(codes.NotFound, "question '%d' is not exists", questionId)
+	}
+	return checkSubjectOpen(subject, time.Now())
+}
+
+func scanSubject(row scanner) (*Subject, error) {
+	subject := &Subject{}
+	var opensAt, closesAt, closedAt sql.NullTime
+	var createdAt, updatedAt time.Time
+
+	if err := row.Scan(&subject.Id, &subject.Title, &subject.Enabled, &opensAt, &closesAt, &closedAt, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	subject.OpensAt = timestampOrNil(opensAt)
+	subject.ClosesAt = timestampOrNil(closesAt)
+	subject.ClosedAt = timestampOrNil(closedAt)
+	subject.CreatedAt = timestamppb.New(createdAt)
+	subject.UpdatedAt = timestamppb.New(updatedAt)
+
+	return subject, nil
+}
+
+func selectQuestionSubject(stmts *boardStatements, questionId int64) (*Subject, error) {
+	subject, err := scanSubject(stmts.selectQuestionSubject.QueryRow(questionId))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return subject, err
+}
+
+func updateSubjectSchedule(ctx context.Context, db *sql.DB, id int64, opensAt, closesAt sql.NullTime) (*Subject, error) {
+	var subject *Subject
+
+	err := inTx(db, func(tx *sql.Tx) error {
+		before, err := selectSubjectForUpdate(tx, id)
+		if err != nil {
+			return err
+		}
+		if before == nil || before.ClosedAt != nil {
+			return status.Errorf(codes.FailedPrecondition, "subject '%d' is not exists or closed", id)
+		}
+
+		row := tx.QueryRow(
+			"UPDATE subject SET opens_at = $1, closes_at = $2, schedule_state = '', updated_at = now() WHERE id = $3 RETURNING "+subjectColumns,
+			opensAt, closesAt, id)
+		if subject, err = scanSubject(row); err != nil {
+			return err
+		}
+
+		return insertAudit(tx, newAuditEntry(ctx, "subject", id, before, subject))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return subject, nil
+}
+
+func closeSubject(ctx context.Context, db *sql.DB, id int64) (*Subject, error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	before, err := selectSubjectForUpdate(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if before == nil || before.ClosedAt != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "subject '%d' is not exists or already closed", id)
+	}
+
+	row := tx.QueryRow(
+		"UPDATE subject SET closed_at = now(), enabled = false, updated_at = now() WHERE id = $1 RETURNING "+subjectColumns,
+		id)
+
+	subject, err := scanSubject(row)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(`
+INSERT INTO subject_ranking(subject_id, rank, question_id, question, likes)
+SELECT subject_id, rank() OVER (ORDER BY likes DESC), id, question, likes
+  FROM question
+ WHERE subject_id = $1 AND deleted_at IS NULL;`, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := insertAudit(tx, newAuditEntry(ctx, "subject", id, before, subject)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return subject, nil
+}
+
+// deleteSubject hides the subject and, through it, all of its questions
+// until it is restored or purged.
+func deleteSubject(ctx context.Context, db *sql.DB, id int64) error {
+	return inTx(db, func(tx *sql.Tx) error {
+		before, err := selectSubjectForUpdate(tx, id)
+		if err != nil {
+			return err
+		}
+		if before == nil {
+			return status.Errorf(codes.NotFound, "subject '%d' is not exists", id)
+		}
+
+		if _, err := tx.Exec("UPDATE subject SET deleted_at = now(), updated_at = now() WHERE id = $1", id); err != nil {
+			return err
+		}
+
+		return insertAudit(tx, newAuditEntry(ctx, "subject", id, before, nil))
+	})
+}
+
+func restoreSubject(ctx context.Context, db *sql.DB, id int64) (*Subject, error) {
+	var subject *Subject
+
+	err := inTx(db, func(tx *sql.Tx) error {
+		row := tx.QueryRow(
+			"UPDATE subject SET deleted_at = NULL, updated_at = now() WHERE id = $1 AND deleted_at IS NOT NULL RETURNING "+subjectColumns,
+			id)
+
+		var err error
+		subject, err = scanSubject(row)
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "deleted subject '%d' is not exists", id)
+		}
+		if err != nil {
+			return err
+		}
+
+		return insertAudit(tx, newAuditEntry(ctx, "subject", id, nil, subject))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return subject, nil
+}
+
+func selectSubjectForUpdate(tx *sql.Tx, id int64) (*Subject, error) {
+	subject, err := scanSubject(tx.QueryRow("SELECT "+subjectColumns+" FROM subject WHERE id = $1 AND deleted_at IS NULL FOR UPDATE", id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return subject, err
+}
+
+func selectRanking(ctx context.Context, db *sql.DB, subjectId int64) ([]*RankedQuestion, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT rank, question_id, question, likes FROM subject_ranking WHERE subject_id = $1 ORDER BY rank, question;",
+		subjectId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*RankedQuestion
+
+	for rows.Next() {
+		ranked := &RankedQuestion{}
+		if err := rows.Scan(&ranked.Rank, &ranked.QuestionId, &ranked.Question, &ranked.LikesCount); err != nil {
+			return nil, err
+		}
+		list = append(list, ranked)
+	}
+
+	return list, rows.Err()
+}
+
+// updateSubjects runs a scheduler UPDATE and audits every subject it
+// changed, with no actor since no one asked for it.
+func updateSubjects(db *sql.DB, stmt string) ([]*Subject, error) {
+	var list []*Subject
+
+	err := inTx(db, func(tx *sql.Tx) error {
+		rows, err := tx.Query(stmt)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		var entries []auditEntry
+
+		for rows.Next() {
+			subject, err := scanSubject(rows)
+			if err != nil {
+				return err
+			}
+			list = append(list, subject)
+			entries = append(entries, auditEntry{
+				method:     "SubjectScheduler",
+				targetType: "subject",
+				targetId:   subject.Id,
+				after:      subject,
+				createdAt:  time.Now(),
+			})
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		rows.Close()
+
+		return insertAudit(tx, entries...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func nullTime(ts *timestamppb.Timestamp) sql.NullTime {
+	if ts == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: ts.AsTime(), Valid: true}
+}
+
+func timestampOrNil(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
@@ -0,0 +1,182 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// subjectEvents drains the events published so far about the subject.
+func subjectEvents(events chan *SubjectEvent, subjectId int64) []SubjectEvent_Type {
+	var list []SubjectEvent_Type
+	for {
+		select {
+		case event := <-events:
+			if event.Subject.Id == subjectId {
+				list = append(list, event.Type)
+			}
+		default:
+			return list
+		}
+	}
+}
+
+func countAudits(t *testing.T, db *sql.DB, subjectId int64, actorId, method string) int {
+	t.Helper()
+
+	var n int
+	err := db.QueryRow("SELECT count(*) FROM audit_log WHERE target_type = 'subject' AND target_id = $1 AND actor_id = $2 AND method = $3",
+		subjectId, actorId, method).Scan(&n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestFlipSchedules(t *testing.T) {
+	db := openTestDB(t)
+	subjectId, _ := insertTestQuestion(t, db, 0)
+	_, err := db.Exec("UPDATE subject SET enabled = false, opens_at = now() - interval '1 minute', closes_at = now() + interval '1 hour' WHERE id = $1",
+		subjectId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	board := &Board{events: newBroker[*SubjectEvent]()}
+	events := board.events.subscribe()
+	defer board.events.unsubscribe(events)
+
+	state := func() (bool, string) {
+		var enabled bool
+		var scheduleState string
+		if err := db.QueryRow("SELECT enabled, schedule_state FROM subject WHERE id = $1", subjectId).Scan(&enabled, &scheduleState); err != nil {
+			t.Fatal(err)
+		}
+		return enabled, scheduleState
+	}
+
+	if err := board.flipSchedules(db); err != nil {
+		t.Fatal(err)
+	}
+	if enabled, s := state(); !enabled || s != "opened" {
+		t.Fatalf("subject = %v, %q, want it enabled and opened", enabled, s)
+	}
+	if got := subjectEvents(events, subjectId); !reflect.DeepEqual(got, []SubjectEvent_Type{SubjectEvent_OPENED}) {
+		t.Fatalf("events = %v, want OPENED", got)
+	}
+	if n := countAudits(t, db, subjectId, "", "SubjectScheduler"); n != 1 {
+		t.Fatalf("%d audits, want 1", n)
+	}
+
+	if _, err := db.Exec("UPDATE subject SET closes_at = now() - interval '1 second' WHERE id = $1", subjectId); err != nil {
+		t.Fatal(err)
+	}
+	if err := board.flipSchedules(db); err != nil {
+		t.Fatal(err)
+	}
+	if enabled, s := state(); enabled || s != "closed" {
+		t.Fatalf("subject = %v, %q, want it disabled and closed", enabled, s)
+	}
+	if got := subjectEvents(events, subjectId); !reflect.DeepEqual(got, []SubjectEvent_Type{SubjectEvent_CLOSED}) {
+		t.Fatalf("events = %v, want CLOSED", got)
+	}
+
+	// a flipped subject is not flipped again
+	if err := board.flipSchedules(db); err != nil {
+		t.Fatal(err)
+	}
+	if got := subjectEvents(events, subjectId); len(got) != 0 {
+		t.Fatalf("events = %v, want none", got)
+	}
+	if n := countAudits(t, db, subjectId, "", "SubjectScheduler"); n != 2 {
+		t.Fatalf("%d audits, want one per flip", n)
+	}
+}
+
+func TestCloseSubject(t *testing.T) {
+	db := openTestDB(t)
+
+	stmts, err := prepareBoardStatements(db, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmts.Close()
+
+	subjectId, first := insertTestQuestion(t, db, 5)
+	var second int64
+	if err := db.QueryRow("INSERT INTO question(question, subject_id, likes) VALUES ('second', $1, 7) RETURNING id", subjectId).Scan(&second); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Exec("DELETE FROM question WHERE id = $1", second) })
+
+	board := &Board{
+		events: newBroker[*SubjectEvent](),
+		likes:  NewLikeBuffer(db, 0, 0),
+		stmts:  stmts,
+	}
+	events := board.events.subscribe()
+	defer board.events.unsubscribe(events)
+
+	tx := sentry.StartTransaction(context.Background(), "test")
+	defer tx.Finish()
+	ctx := context.WithValue(tx.Context(), DBSession, db)
+	mod := context.WithValue(ctx, principalKey, &Principal{UserId: "mod", Moderator: true})
+
+	if _, err := board.GetFinalRanking(ctx, &SubjectId{Id: subjectId}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("GetFinalRanking of an open subject = %v, want FailedPrecondition", err)
+	}
+	if _, err := board.CloseSubject(ctx, &SubjectId{Id: subjectId}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("CloseSubject by a user = %v, want PermissionDenied", err)
+	}
+
+	// buffered likes that put the first question ahead
+	for i := 0; i < 3; i++ {
+		if _, err := board.Like(ctx, &QuestionId{Id: first}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	subject, err := board.CloseSubject(mod, &SubjectId{Id: subjectId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subject.ClosedAt == nil || subject.Enabled {
+		t.Fatalf("subject = %v, want it closed and disabled", subject)
+	}
+	if got := subjectEvents(events, subjectId); !reflect.DeepEqual(got, []SubjectEvent_Type{SubjectEvent_CLOSED}) {
+		t.Fatalf("events = %v, want CLOSED", got)
+	}
+	if n := countAudits(t, db, subjectId, "mod", ""); n != 1 {
+		t.Fatalf("%d audits, want 1", n)
+	}
+	if _, err := board.CloseSubject(mod, &SubjectId{Id: subjectId}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("CloseSubject again = %v, want FailedPrecondition", err)
+	}
+
+	// the ranking is a snapshot of the close
+	if _, err := db.Exec("UPDATE question SET likes = 100 WHERE id = $1", second); err != nil {
+		t.Fatal(err)
+	}
+
+	ranking, err := board.GetFinalRanking(ctx, &SubjectId{Id: subjectId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got [][2]int64
+	for _, r := range ranking.Ranking {
+		got = append(got, [2]int64{r.QuestionId, r.LikesCount})
+	}
+	want := [][2]int64{{first, 8}, {second, 7}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ranking = %v, want %v", got, want)
+	}
+	if ranking.Ranking[0].Rank != 1 || ranking.Ranking[1].Rank != 2 {
+		t.Fatalf("ranks = %d, %d, want 1, 2", ranking.Ranking[0].Rank, ranking.Ranking[1].Rank)
+	}
+}
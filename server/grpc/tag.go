@@ -0,0 +1,314 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const maxTagLength = 50
+
+func (b *Board) ListTags(ctx context.Context, subjectId *SubjectId) (*TagList, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/ListTags")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	tags, err := selectTags(ctx, db, subjectId.Id)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ListTags")
+		return nil, err
+	}
+
+	return &TagList{
+		TagList: tags[subjectId.Id],
+	}, nil
+}
+
+func (b *Board) CreateTag(ctx context.Context, newTag *NewTag) (*Tag, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/CreateTag")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	if !principalFromContext(ctx).Moderator {
+		loggerFromContext(ctx).Errorf("CreateTag: permission denied")
+		return nil, status.Error(codes.PermissionDenied, "only a moderator can manage tags")
+	}
+
+	name := strings.TrimSpace(newTag.GetName())
+	if len(name) == 0 || len(name) > maxTagLength {
+		loggerFromContext(ctx).Errorf("CreateTag: invalid input 'name'")
+		return nil, status.Errorf(codes.InvalidArgument, "tag name must be 1 to %d characters", maxTagLength)
+	}
+
+	subject, err := selectSubject(b.stmts, newTag.SubjectId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CreateTag: failed to select subject")
+		return nil, err
+	}
+	if subject == nil {
+		loggerFromContext(ctx).Errorf("CreateTag: subjectId '%d' is not exists", newTag.SubjectId)
+		return nil, status.Error(codes.NotFound, "this subject is not exists")
+	}
+
+	tag, err := insertTag(ctx, db, newTag.SubjectId, name)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CreateTag")
+		return nil, err
+	}
+
+	b.subjectsChanged(ctx)
+
+	return tag, nil
+}
+
+func (b *Board) DeleteTag(ctx context.Context, tagId *TagId) (*emptypb.Empty, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/DeleteTag")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	if !principalFromContext(ctx).Moderator {
+		loggerFromContext(ctx).Errorf("DeleteTag: permission denied")
+		return nil, status.Error(codes.PermissionDenied, "only a moderator can manage tags")
+	}
+
+	tag, err := deleteTag(ctx, db, tagId.Id)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("DeleteTag")
+		return nil, err
+	}
+	if tag == nil {
+		return &emptypb.Empty{}, nil
+	}
+
+	b.questionsChanged(ctx, tag.SubjectId)
+	b.subjectsChanged(ctx)
+
+	return &emptypb.Empty{}, nil
+}
+
+func (b *Board) SetQuestionTags(ctx context.Context, questionTags *QuestionTags) (*Question, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/board.Board/SetQuestionTags")
+	defer span.Finish()
+
+	subject, err := checkQuestionNotClosed(b.stmts, questionTags.QuestionId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("SetQuestionTags")
+		return nil, err
+	}
+
+	editor := principalFromContext(ctx)
+
+	question, err := updateQuestionTags(ctx, b.stmts, questionTags.QuestionId, uniqueTags(questionTags.Tags), b.canModify(editor))
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("SetQuestionTags")
+		return nil, err
+	}
+
+	b.questionsChanged(ctx, subject.Id)
+	b.subjectsChanged(ctx)
+
+	return question, nil
+}
+
+func attachTags(ctx context.Context, db *sql.DB, subjects ...*Subject) error {
+	ids := make([]int64, 0, len(subjects))
+	for _, s := range subjects {
+		ids = append(ids, s.Id)
+	}
+
+	tags, err := selectTags(ctx, db, ids...)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range subjects {
+		s.Tags = tags[s.Id]
+	}
+	return nil
+}
+
+func selectTags(ctx context.Context, db *sql.DB, subjectIds ...int64) (map[int64][]*Tag, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT t.id, t.subject_id, t.name, count(qt.question_id)
+  FROM tag t
+  JOIN subject s ON s.id = t.subject_id AND s.deleted_at IS NULL
+  LEFT JOIN (question_tag qt JOIN question q ON q.id = qt.question_id AND q.deleted_at IS NULL) ON qt.tag_id = t.id
+ WHERE t.subject_id = ANY($1)
+ GROUP BY t.id
+ ORDER BY t.subject_id, t.name;`, pq.Array(subjectIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tags := make(map[int64][]*Tag)
+
+	for rows.Next() {
+		tag := &Tag{}
+		if err := rows.Scan(&tag.Id, &tag.SubjectId, &tag.Name, &tag.QuestionCount); err != nil {
+			return nil, err
+		}
+		tags[tag.SubjectId] = append(tags[tag.SubjectId], tag)
+	}
+
+	return tags, rows.Err()
+}
+
+func insertTag(ctx context.Context, db *sql.DB, subjectId int64, name string) (*Tag, error) {
+	tag := &Tag{
+		SubjectId: subjectId,
+		Name:      name,
+	}
+
+	err := inTx(db, func(tx *sql.Tx) error {
+		err := tx.QueryRow(
+			"INSERT INTO tag(subject_id, name) VALUES ($1, $2) RETURNING id",
+			subjectId, name).Scan(&tag.Id)
+		if err != nil {
+			return err
+		}
+
+		return insertAudit(tx, newAuditEntry(ctx, "tag", tag.Id, nil, tag))
+	})
+	if err, ok := err.(*pq.Error); ok && err.Code == "23505" {
+		return nil, status.Errorf(codes.AlreadyExists, "tag '%s' already exists", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// deleteTag returns the deleted tag, or nil when it did not exist.
+func deleteTag(ctx context.Context, db *sql.DB, id int64) (*Tag, error) {
+	var tag *Tag
+
+	err := inTx(db, func(tx *sql.Tx) error {
+		deleted := &Tag{Id: id}
+		err := tx.QueryRow("DELETE FROM tag WHERE id = $1 RETURNING subject_id, name", id).Scan(&deleted.SubjectId, &deleted.Name)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		tag = deleted
+
+		return insertAudit(tx, newAuditEntry(ctx, "tag", id, tag, nil))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+func updateQuestionTags(ctx context.Context, stmts *boardStatements, questionId int64, names []string, authorize func(*Question) error) (*Question, error) {
+	tx, err := stmts.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	question, err := selectQuestionForUpdate(tx, stmts, questionId)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorize(question); err != nil {
+		return nil, err
+	}
+
+	before := proto.Clone(question).(*Question)
+	err = tx.QueryRow(`
+SELECT COALESCE(array_agg(t.name ORDER BY t.name), '{}')
+  FROM question_tag qt
+  JOIN tag t ON t.id = qt.tag_id
+ WHERE qt.question_id = $1;`, questionId).Scan(pq.Array(&before.Tags))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(`
+SELECT t.id, t.name
+  FROM tag t
+  JOIN question q ON q.subject_id = t.subject_id
+ WHERE q.id = $1 AND t.name = ANY($2)
+ ORDER BY t.name;`, questionId, pq.Array(names))
+	if err != nil {
+		return nil, err
+	}
+
+	var tagIds []int64
+	question.Tags = nil
+
+	for rows.Next() {
+		var id int64
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		tagIds = append(tagIds, id)
+		question.Tags = append(question.Tags, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(tagIds) != len(names) {
+		return nil, status.Error(codes.InvalidArgument, "some tags are not in this subject's vocabulary")
+	}
+
+	if _, err := tx.Exec("DELETE FROM question_tag WHERE question_id = $1", questionId); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(
+		"INSERT INTO question_tag(question_id, tag_id) SELECT $1, unnest($2::bigint[])",
+		questionId, pq.Array(tagIds))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := insertAudit(tx, newAuditEntry(ctx, "question", questionId, before, question)); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+func uniqueTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	unique := make([]string, 0, len(tags))
+
+	for _, t := range tags {
+		t = strings.TrimSpace(t)
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		unique = append(unique, t)
+	}
+
+	return unique
+}
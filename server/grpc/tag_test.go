@@ -0,0 +1,194 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+
+	// external packages
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUniqueTags(t *testing.T) {
+	tests := []struct {
+		tags []string
+		want []string
+	}{
+		{nil, []string{}},
+		{[]string{"a", "b"}, []string{"a", "b"}},
+		{[]string{" a", "a ", "b", "a"}, []string{"a", "b"}},
+		{[]string{"", "  ", "b"}, []string{"b"}},
+	}
+
+	for _, tt := range tests {
+		if got := uniqueTags(tt.tags); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("uniqueTags(%q) = %q, want %q", tt.tags, got, tt.want)
+		}
+	}
+}
+
+// insertTestTaggedQuestions creates a subject with the tags a and b and
+// three questions: one tagged a, one tagged a and b, and one tagged b that
+// is deleted.
+func insertTestTaggedQuestions(t *testing.T, db *sql.DB, stmts *boardStatements) (int64, []int64) {
+	t.Helper()
+	ctx := context.Background()
+	allow := func(*Question) error { return nil }
+
+	// likes keep the questions in the order they were created
+	subjectId, first := insertTestQuestion(t, db, 2)
+	questionIds := []int64{first}
+	for _, q := range []string{"second", "deleted"} {
+		var id int64
+		if err := db.QueryRow("INSERT INTO question(question, subject_id, likes) VALUES ($1, $2, 1) RETURNING id", q, subjectId).Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Exec("DELETE FROM question WHERE id = $1", id) })
+		questionIds = append(questionIds, id)
+	}
+
+	for _, name := range []string{"a", "b"} {
+		if _, err := insertTag(ctx, db, subjectId, name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i, tags := range [][]string{{"a"}, {"a", "b"}, {"b"}} {
+		if _, err := updateQuestionTags(ctx, stmts, questionIds[i], tags, allow); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.Exec("UPDATE question SET deleted_at = now() WHERE id = $1", questionIds[2]); err != nil {
+		t.Fatal(err)
+	}
+
+	return subjectId, questionIds
+}
+
+func TestUpdateQuestionTags(t *testing.T) {
+	db := openTestDB(t)
+
+	stmts, err := prepareBoardStatements(db, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmts.Close()
+
+	ctx := context.Background()
+	allow := func(*Question) error { return nil }
+	subjectId, questionId := insertTestQuestion(t, db, 0)
+	otherSubjectId, _ := insertTestQuestion(t, db, 0)
+	for _, tag := range []struct {
+		subjectId int64
+		name      string
+	}{{subjectId, "a"}, {subjectId, "b"}, {otherSubjectId, "c"}} {
+		if _, err := insertTag(ctx, db, tag.subjectId, tag.name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	question, err := updateQuestionTags(ctx, stmts, questionId, []string{"b", "a"}, allow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(question.Tags, []string{"a", "b"}) {
+		t.Fatalf("tags = %q, want [a b]", question.Tags)
+	}
+
+	tests := []struct {
+		name string
+		tags []string
+		code codes.Code
+	}{
+		{"unknown tag", []string{"a", "x"}, codes.InvalidArgument},
+		{"tag of another subject", []string{"c"}, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := updateQuestionTags(ctx, stmts, questionId, tt.tags, allow); status.Code(err) != tt.code {
+				t.Fatalf("updateQuestionTags = %v, want %v", err, tt.code)
+			}
+		})
+	}
+
+	denied := status.Error(codes.PermissionDenied, "denied")
+	if _, err := updateQuestionTags(ctx, stmts, questionId, nil, func(*Question) error { return denied }); err != denied {
+		t.Fatalf("updateQuestionTags = %v, want the authorize error", err)
+	}
+
+	list, err := selectQuestions(ctx, stmts, &QuestionFilter{SubjectId: subjectId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || !reflect.DeepEqual(list[0].Tags, []string{"a", "b"}) {
+		t.Fatalf("questions = %v, want the tags left as set", list)
+	}
+}
+
+func TestAttachTags(t *testing.T) {
+	db := openTestDB(t)
+
+	stmts, err := prepareBoardStatements(db, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmts.Close()
+
+	subjectId, _ := insertTestTaggedQuestions(t, db, stmts)
+	subject := &Subject{Id: subjectId}
+	if err := attachTags(context.Background(), db, subject); err != nil {
+		t.Fatal(err)
+	}
+
+	counts := map[string]int64{}
+	for _, tag := range subject.Tags {
+		counts[tag.Name] = tag.QuestionCount
+	}
+	// the deleted question is not counted
+	if want := map[string]int64{"a": 2, "b": 1}; !reflect.DeepEqual(counts, want) {
+		t.Fatalf("tag counts = %v, want %v", counts, want)
+	}
+}
+
+func TestSelectQuestionsByTags(t *testing.T) {
+	db := openTestDB(t)
+
+	stmts, err := prepareBoardStatements(db, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stmts.Close()
+
+	subjectId, ids := insertTestTaggedQuestions(t, db, stmts)
+
+	tests := []struct {
+		name     string
+		tags     []string
+		matchAll bool
+		want     []int64
+	}{
+		{"no tags", nil, false, []int64{ids[0], ids[1]}},
+		{"any tag", []string{"a", "b"}, false, []int64{ids[0], ids[1]}},
+		{"all tags", []string{"a", "b"}, true, []int64{ids[1]}},
+		{"all of a repeated tag", []string{"a", "a"}, true, []int64{ids[0], ids[1]}},
+		{"all tags with an unknown one", []string{"a", "x"}, true, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := selectQuestions(context.Background(), stmts, &QuestionFilter{SubjectId: subjectId, Tags: tt.tags, MatchAll: tt.matchAll})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []int64
+			for _, q := range list {
+				got = append(got, q.Id)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("questions = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
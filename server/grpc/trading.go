@@ -0,0 +1,586 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const orderColumns = "o.id, o.account_id, s.code, o.side, o.type, o.price, o.quantity, o.filled_quantity, o.status, o.created_at"
+
+type Trading struct {
+	TradingServer
+
+	feed        *PriceFeed
+	calendar    *MarketCalendar
+	initialCash float64
+
+	mu       sync.Mutex
+	books    map[string]*orderBook
+	updates  *broker[*OrderBook]
+	orders   *broker[*Order]
+	sessions *broker[*MarketSession]
+}
+
+func NewTrading(db *sql.DB, feed *PriceFeed, calendar *MarketCalendar, initialCash float64) (*Trading, error) {
+	t := &Trading{
+		feed:        feed,
+		calendar:    calendar,
+		initialCash: initialCash,
+		books:       make(map[string]*orderBook),
+		updates:     newBroker[*OrderBook](),
+		orders:      newBroker[*Order](),
+		sessions:    newBroker[*MarketSession](),
+	}
+
+	orders, err := selectOpenOrders(db)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range orders {
+		t.book(o.Code).add(o)
+	}
+
+	return t, nil
+}
+
+func (t *Trading) PlaceOrder(ctx context.Context, newOrder *NewOrder) (*OrderResult, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/trading.Trading/PlaceOrder")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	owner := principalFromContext(ctx)
+	if owner.Anonymous() {
+		loggerFromContext(ctx).Errorf("PlaceOrder: anonymous principal")
+		return nil, status.Error(codes.Unauthenticated, "an account is required to place an order")
+	}
+
+	if err := checkSessionOpen(t.calendar, time.Now()); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("PlaceOrder")
+		return nil, err
+	}
+
+	stock, err := selectStock(ctx, db, "code", strings.ToUpper(newOrder.Code))
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("PlaceOrder")
+		return nil, err
+	}
+
+	if err := validateOrder(newOrder.Side, newOrder.Type, newOrder.Price, newOrder.Quantity, stock); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("PlaceOrder")
+		return nil, err
+	}
+
+	order := &Order{
+		AccountId: owner.UserId,
+		Code:      stock.Code,
+		Side:      newOrder.Side,
+		Type:      newOrder.Type,
+		Quantity:  newOrder.Quantity,
+		Status:    OrderStatus_OPEN,
+	}
+	if order.Type == OrderType_LIMIT {
+		order.Price = fromTicks(toTicks(newOrder.Price))
+	}
+
+	book := t.book(stock.Code)
+	book.mu.Lock()
+	defer book.mu.Unlock()
+
+	result, err := t.execute(db, book, order, stock.Id, false)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("PlaceOrder")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (t *Trading) CancelOrder(ctx context.Context, orderId *OrderId) (*Order, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/trading.Trading/CancelOrder")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	order, _, err := selectOrder(ctx, db, orderId.Id)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CancelOrder")
+		return nil, err
+	}
+	if err := checkOrderOwner(ctx, order); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CancelOrder")
+		return nil, err
+	}
+
+	book := t.book(order.Code)
+	book.mu.Lock()
+	defer book.mu.Unlock()
+
+	resting, ok := book.orders[order.Id]
+	if !ok {
+		loggerFromContext(ctx).Errorf("CancelOrder: order '%d' is not open", order.Id)
+		return nil, status.Errorf(codes.FailedPrecondition, "order '%d' is not open", order.Id)
+	}
+
+	cancelled := proto.Clone(resting.order).(*Order)
+	cancelled.Status = OrderStatus_CANCELLED
+
+	if err := updateOrderStatus(db, cancelled); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CancelOrder")
+		return nil, err
+	}
+
+	book.remove(order.Id)
+	t.updates.publish(book.snapshot(maxBookDepth))
+	t.orders.publish(cancelled)
+
+	return cancelled, nil
+}
+
+func (t *Trading) ReplaceOrder(ctx context.Context, replacement *OrderReplacement) (*OrderResult, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/trading.Trading/ReplaceOrder")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	order, stockId, err := selectOrder(ctx, db, replacement.Id)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ReplaceOrder")
+		return nil, err
+	}
+	if err := checkOrderOwner(ctx, order); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ReplaceOrder")
+		return nil, err
+	}
+	if err := checkSessionOpen(t.calendar, time.Now()); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ReplaceOrder")
+		return nil, err
+	}
+
+	stock, err := selectStock(ctx, db, "id", stockId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ReplaceOrder")
+		return nil, err
+	}
+
+	book := t.book(order.Code)
+	book.mu.Lock()
+	defer book.mu.Unlock()
+
+	resting, ok := book.orders[order.Id]
+	if !ok {
+		loggerFromContext(ctx).Errorf("ReplaceOrder: order '%d' is not open", order.Id)
+		return nil, status.Errorf(codes.FailedPrecondition, "order '%d' is not open", order.Id)
+	}
+
+	if err := validateOrder(resting.order.Side, resting.order.Type, replacement.Price, replacement.Quantity, stock); err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ReplaceOrder")
+		return nil, err
+	}
+	if replacement.Quantity <= resting.order.FilledQuantity {
+		loggerFromContext(ctx).Errorf("ReplaceOrder: quantity is not above the filled quantity")
+		return nil, status.Error(codes.InvalidArgument, "'quantity' must be above the filled quantity")
+	}
+
+	replaced := proto.Clone(resting.order).(*Order)
+	replaced.Price = fromTicks(toTicks(replacement.Price))
+	replaced.Quantity = replacement.Quantity
+	replaced.Status = orderStatus(replaced)
+
+	// only shrinking the quantity at the same price keeps time priority
+	if toTicks(replaced.Price) == resting.ticks && replaced.Quantity <= resting.order.Quantity {
+		if err := updateOrderQuantity(db, replaced); err != nil {
+			loggerFromContext(ctx).WithError(err).Error("ReplaceOrder")
+			return nil, err
+		}
+		book.update(replaced)
+		t.updates.publish(book.snapshot(maxBookDepth))
+		t.orders.publish(replaced)
+
+		return &OrderResult{Order: replaced}, nil
+	}
+
+	result, err := t.execute(db, book, replaced, stock.Id, true)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ReplaceOrder")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (t *Trading) StreamOrderBook(request *OrderBookRequest, stream Trading_StreamOrderBookServer) error {
+	code := strings.ToUpper(request.Code)
+
+	depth := int(request.Depth)
+	if depth <= 0 {
+		depth = defaultBookDepth
+	}
+	if depth > maxBookDepth {
+		depth = maxBookDepth
+	}
+
+	updates := t.updates.subscribe()
+	defer t.updates.unsubscribe(updates)
+
+	if err := stream.Send(t.snapshot(code, depth)); err != nil {
+		loggerFromContext(stream.Context()).WithError(err).Error("StreamOrderBook")
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case book := <-updates:
+			if book.Code != code {
+				continue
+			}
+			if err := stream.Send(trimBook(book, depth)); err != nil {
+				loggerFromContext(stream.Context()).WithError(err).Error("StreamOrderBook")
+				return err
+			}
+		}
+	}
+}
+
+func (t *Trading) StreamOrders(_ *emptypb.Empty, stream Trading_StreamOrdersServer) error {
+	owner := principalFromContext(stream.Context())
+	if owner.Anonymous() {
+		loggerFromContext(stream.Context()).Errorf("StreamOrders: anonymous principal")
+		return status.Error(codes.Unauthenticated, "an account is required to stream orders")
+	}
+
+	orders := t.orders.subscribe()
+	defer t.orders.unsubscribe(orders)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case order := <-orders:
+			if order.AccountId != owner.UserId {
+				continue
+			}
+			if err := stream.Send(order); err != nil {
+				loggerFromContext(stream.Context()).WithError(err).Error("StreamOrders")
+				return err
+			}
+		}
+	}
+}
+
+// execute matches order against the book, checks funds, persists and
+// settles the outcome in one transaction and only then applies it to the
+// book. An order that would trade against an order of the same account is
+// rejected before anything is written. Callers hold book.mu.
+func (t *Trading) execute(db *sql.DB, book *orderBook, order *Order, stockId int64, replace bool) (*OrderResult, error) {
+	fills := book.plan(order)
+	if own := selfTrade(order, fills); own != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "order would trade against your own order '%d'", own.Id)
+	}
+
+	updated := proto.Clone(order).(*Order)
+	counterparts := make([]*Order, len(fills))
+	trades := make([]*Trade, len(fills))
+	now := timestamppb.Now()
+
+	for i, f := range fills {
+		counterpart := proto.Clone(f.resting.order).(*Order)
+		counterpart.FilledQuantity += f.quantity
+		counterpart.Status = orderStatus(counterpart)
+		counterparts[i] = counterpart
+
+		updated.FilledQuantity += f.quantity
+
+		trades[i] = &Trade{
+			Code:     book.code,
+			Price:    fromTicks(f.ticks),
+			Quantity: f.quantity,
+			Time:     now,
+		}
+	}
+	updated.Status = orderStatus(updated)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	accounts := []string{updated.AccountId}
+	for _, c := range counterparts {
+		accounts = append(accounts, c.AccountId)
+	}
+
+	cash, err := lockAccounts(tx, accounts, t.initialCash)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkFunds(tx, updated, fills, stockId, cash); err != nil {
+		return nil, err
+	}
+
+	if replace {
+		err = replaceOrder(tx, updated)
+	} else {
+		err = insertOrder(tx, updated, stockId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i, trade := range trades {
+		trade.BuyOrderId, trade.SellOrderId = updated.Id, counterparts[i].Id
+		if updated.Side == Side_SELL {
+			trade.BuyOrderId, trade.SellOrderId = counterparts[i].Id, updated.Id
+		}
+		if err := insertTrade(tx, trade, stockId); err != nil {
+			return nil, err
+		}
+		if err := updateOrderFill(tx, counterparts[i]); err != nil {
+			return nil, err
+		}
+
+		buyer, seller := updated.AccountId, counterparts[i].AccountId
+		if updated.Side == Side_SELL {
+			buyer, seller = seller, buyer
+		}
+		if err := settleTrade(tx, trade, buyer, seller, stockId); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	book.apply(updated, fills, counterparts, trades)
+	t.updates.publish(book.snapshot(maxBookDepth))
+	t.orders.publish(updated)
+	for _, c := range counterparts {
+		t.orders.publish(c)
+	}
+
+	return &OrderResult{
+		Order:  updated,
+		Trades: trades,
+	}, nil
+}
+
+func (t *Trading) book(code string) *orderBook {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	book, ok := t.books[code]
+	if !ok {
+		book = newOrderBook(code)
+		t.books[code] = book
+	}
+	return book
+}
+
+func (t *Trading) lastTrade(code string) *Trade {
+	t.mu.Lock()
+	book, ok := t.books[code]
+	t.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	book.mu.Lock()
+	defer book.mu.Unlock()
+
+	return book.lastTrade
+}
+
+func (t *Trading) snapshot(code string, depth int) *OrderBook {
+	t.mu.Lock()
+	book, ok := t.books[code]
+	t.mu.Unlock()
+
+	if !ok {
+		return &OrderBook{Code: code, Time: timestamppb.Now()}
+	}
+
+	book.mu.Lock()
+	defer book.mu.Unlock()
+
+	return book.snapshot(depth)
+}
+
+func trimBook(book *OrderBook, depth int) *OrderBook {
+	trimmed := &OrderBook{
+		Code:      book.Code,
+		Bids:      book.Bids,
+		Asks:      book.Asks,
+		LastTrade: book.LastTrade,
+		Time:      book.Time,
+	}
+	if len(trimmed.Bids) > depth {
+		trimmed.Bids = trimmed.Bids[:depth]
+	}
+	if len(trimmed.Asks) > depth {
+		trimmed.Asks = trimmed.Asks[:depth]
+	}
+	return trimmed
+}
+
+func validateOrder(side Side, orderType OrderType, price float64, quantity int64, stock *Stock) error {
+	if side != Side_BUY && side != Side_SELL {
+		return status.Error(codes.InvalidArgument, "invalid input 'side'")
+	}
+	if orderType != OrderType_LIMIT && orderType != OrderType_MARKET {
+		return status.Error(codes.InvalidArgument, "invalid input 'type'")
+	}
+	if orderType == OrderType_LIMIT && toTicks(price) <= 0 {
+		return status.Error(codes.InvalidArgument, "'price' must be positive for a limit order")
+	}
+	if quantity <= 0 {
+		return status.Error(codes.InvalidArgument, "'quantity' must be positive")
+	}
+	if quantity > stock.TotalStockCount {
+		return status.Errorf(codes.InvalidArgument, "'quantity' exceeds the %d shares of %s", stock.TotalStockCount, stock.Code)
+	}
+	return nil
+}
+
+func checkOrderOwner(ctx context.Context, order *Order) error {
+	p := principalFromContext(ctx)
+	if p.Moderator || (!p.Anonymous() && p.UserId == order.AccountId) {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "only the owner can change this order")
+}
+
+func scanOrder(row scanner, extra ...interface{}) (*Order, error) {
+	order := &Order{}
+	var side, orderType, state string
+	var createdAt time.Time
+
+	dest := []interface{}{
+		&order.Id, &order.AccountId, &order.Code, &side, &orderType, &order.Price,
+		&order.Quantity, &order.FilledQuantity, &state, &createdAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	order.Side = Side(Side_value[side])
+	order.Type = OrderType(OrderType_value[orderType])
+	order.Status = OrderStatus(OrderStatus_value[state])
+	order.CreatedAt = timestamppb.New(createdAt)
+
+	return order, nil
+}
+
+func selectOrder(ctx context.Context, db *sql.DB, id int64) (*Order, int64, error) {
+	row := db.QueryRowContext(ctx,
+		"SELECT "+orderColumns+", o.stock_id FROM orders o JOIN stocks s ON s.id = o.stock_id WHERE o.id = $1",
+		id)
+
+	var stockId int64
+	order, err := scanOrder(row, &stockId)
+	if err == sql.ErrNoRows {
+		return nil, 0, status.Errorf(codes.NotFound, "order '%d' is not exists", id)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return order, stockId, nil
+}
+
+func selectOpenOrders(db *sql.DB) ([]*Order, error) {
+	rows, err := db.Query(`
+SELECT ` + orderColumns + `
+  FROM orders o
+  JOIN stocks s ON s.id = o.stock_id
+ WHERE o.status IN ('OPEN', 'PARTIALLY_FILLED')
+ ORDER BY o.priority_at, o.id;`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Order
+
+	for rows.Next() {
+		order, err := scanOrder(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, order)
+	}
+
+	return list, rows.Err()
+}
+
+func insertOrder(tx *sql.Tx, order *Order, stockId int64) error {
+	var createdAt time.Time
+
+	err := tx.QueryRow(`
+INSERT INTO orders(account_id, stock_id, side, type, price, quantity, filled_quantity, status)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, created_at`,
+		order.AccountId, stockId, order.Side.String(), order.Type.String(), order.Price,
+		order.Quantity, order.FilledQuantity, order.Status.String()).Scan(&order.Id, &createdAt)
+	if err != nil {
+		return err
+	}
+	order.CreatedAt = timestamppb.New(createdAt)
+
+	return nil
+}
+
+func replaceOrder(tx *sql.Tx, order *Order) error {
+	_, err := tx.Exec(`
+UPDATE orders
+   SET price = $1, quantity = $2, filled_quantity = $3, status = $4, updated_at = now(), priority_at = now()
+ WHERE id = $5`,
+		order.Price, order.Quantity, order.FilledQuantity, order.Status.String(), order.Id)
+	return err
+}
+
+func updateOrderFill(tx *sql.Tx, order *Order) error {
+	_, err := tx.Exec(
+		"UPDATE orders SET filled_quantity = $1, status = $2, updated_at = now() WHERE id = $3",
+		order.FilledQuantity, order.Status.String(), order.Id)
+	return err
+}
+
+func updateOrderQuantity(db *sql.DB, order *Order) error {
+	_, err := db.Exec(
+		"UPDATE orders SET quantity = $1, status = $2, updated_at = now() WHERE id = $3",
+		order.Quantity, order.Status.String(), order.Id)
+	return err
+}
+
+func updateOrderStatus(db *sql.DB, order *Order) error {
+	_, err := db.Exec(
+		"UPDATE orders SET status = $1, updated_at = now() WHERE id = $2",
+		order.Status.String(), order.Id)
+	return err
+}
+
+func insertTrade(tx *sql.Tx, trade *Trade, stockId int64) error {
+	return tx.QueryRow(`
+INSERT INTO trade(stock_id, price, quantity, buy_order_id, sell_order_id, time)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id`,
+		stockId, trade.Price, trade.Quantity, trade.BuyOrderId, trade.SellOrderId, trade.Time.AsTime()).Scan(&trade.Id)
+}
@@ -0,0 +1,552 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.23.4
+// source: trading.proto
+
+package grpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TradingClient is the client API for Trading service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TradingClient interface {
+	PlaceOrder(ctx context.Context, in *NewOrder, opts ...grpc.CallOption) (*OrderResult, error)
+	CancelOrder(ctx context.Context, in *OrderId, opts ...grpc.CallOption) (*Order, error)
+	ReplaceOrder(ctx context.Context, in *OrderReplacement, opts ...grpc.CallOption) (*OrderResult, error)
+	StreamOrderBook(ctx context.Context, in *OrderBookRequest, opts ...grpc.CallOption) (Trading_StreamOrderBookClient, error)
+	// StreamOrders sends every change of the caller's orders, including the
+	// cancellations made by a stock split.
+	StreamOrders(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Trading_StreamOrdersClient, error)
+	Deposit(ctx context.Context, in *CashDeposit, opts ...grpc.CallOption) (*Balance, error)
+	GetBalance(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*Balance, error)
+	ListPositions(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*PositionList, error)
+	GetPnL(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*PnL, error)
+	GetMarketSession(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MarketSession, error)
+	StreamMarketSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Trading_StreamMarketSessionsClient, error)
+}
+
+type tradingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTradingClient(cc grpc.ClientConnInterface) TradingClient {
+	return &tradingClient{cc}
+}
+
+func (c *tradingClient) PlaceOrder(ctx context.Context, in *NewOrder, opts ...grpc.CallOption) (*OrderResult, error) {
+	out := new(OrderResult)
+	err := c.cc.Invoke(ctx, "/trading.Trading/PlaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingClient) CancelOrder(ctx context.Context, in *OrderId, opts ...grpc.CallOption) (*Order, error) {
+	out := new(Order)
+	err := c.cc.Invoke(ctx, "/trading.Trading/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingClient) ReplaceOrder(ctx context.Context, in *OrderReplacement, opts ...grpc.CallOption) (*OrderResult, error) {
+	out := new(OrderResult)
+	err := c.cc.Invoke(ctx, "/trading.Trading/ReplaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingClient) StreamOrderBook(ctx context.Context, in *OrderBookRequest, opts ...grpc.CallOption) (Trading_StreamOrderBookClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Trading_ServiceDesc.Streams[0], "/trading.Trading/StreamOrderBook", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tradingStreamOrderBookClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Trading_StreamOrderBookClient interface {
+	Recv() (*OrderBook, error)
+	grpc.ClientStream
+}
+
+type tradingStreamOrderBookClient struct {
+	grpc.ClientStream
+}
+
+func (x *tradingStreamOrderBookClient) Recv() (*OrderBook, error) {
+	m := new(OrderBook)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tradingClient) StreamOrders(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Trading_StreamOrdersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Trading_ServiceDesc.Streams[1], "/trading.Trading/StreamOrders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tradingStreamOrdersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Trading_StreamOrdersClient interface {
+	Recv() (*Order, error)
+	grpc.ClientStream
+}
+
+type tradingStreamOrdersClient struct {
+	grpc.ClientStream
+}
+
+func (x *tradingStreamOrdersClient) Recv() (*Order, error) {
+	m := new(Order)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tradingClient) Deposit(ctx context.Context, in *CashDeposit, opts ...grpc.CallOption) (*Balance, error) {
+	out := new(Balance)
+	err := c.cc.Invoke(ctx, "/trading.Trading/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingClient) GetBalance(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*Balance, error) {
+	out := new(Balance)
+	err := c.cc.Invoke(ctx, "/trading.Trading/GetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingClient) ListPositions(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*PositionList, error) {
+	out := new(PositionList)
+	err := c.cc.Invoke(ctx, "/trading.Trading/ListPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingClient) GetPnL(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*PnL, error) {
+	out := new(PnL)
+	err := c.cc.Invoke(ctx, "/trading.Trading/GetPnL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingClient) GetMarketSession(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MarketSession, error) {
+	out := new(MarketSession)
+	err := c.cc.Invoke(ctx, "/trading.Trading/GetMarketSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tradingClient) StreamMarketSessions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Trading_StreamMarketSessionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Trading_ServiceDesc.Streams[2], "/trading.Trading/StreamMarketSessions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tradingStreamMarketSessionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Trading_StreamMarketSessionsClient interface {
+	Recv() (*MarketSession, error)
+	grpc.ClientStream
+}
+
+type tradingStreamMarketSessionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *tradingStreamMarketSessionsClient) Recv() (*MarketSession, error) {
+	m := new(MarketSession)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TradingServer is the server API for Trading service.
+// All implementations must embed UnimplementedTradingServer
+// for forward compatibility
+type TradingServer interface {
+	PlaceOrder(context.Context, *NewOrder) (*OrderResult, error)
+	CancelOrder(context.Context, *OrderId) (*Order, error)
+	ReplaceOrder(context.Context, *OrderReplacement) (*OrderResult, error)
+	StreamOrderBook(*OrderBookRequest, Trading_StreamOrderBookServer) error
+	// StreamOrders sends every change of the caller's orders, including the
+	// cancellations made by a stock split.
+	StreamOrders(*emptypb.Empty, Trading_StreamOrdersServer) error
+	Deposit(context.Context, *CashDeposit) (*Balance, error)
+	GetBalance(context.Context, *AccountRequest) (*Balance, error)
+	ListPositions(context.Context, *AccountRequest) (*PositionList, error)
+	GetPnL(context.Context, *AccountRequest) (*PnL, error)
+	GetMarketSession(context.Context, *emptypb.Empty) (*MarketSession, error)
+	StreamMarketSessions(*emptypb.Empty, Trading_StreamMarketSessionsServer) error
+	mustEmbedUnimplementedTradingServer()
+}
+
+// UnimplementedTradingServer must be embedded to have forward compatible implementations.
+type UnimplementedTradingServer struct {
+}
+
+func (UnimplementedTradingServer) PlaceOrder(context.Context, *NewOrder) (*OrderResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (UnimplementedTradingServer) CancelOrder(context.Context, *OrderId) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedTradingServer) ReplaceOrder(context.Context, *OrderReplacement) (*OrderResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceOrder not implemented")
+}
+func (UnimplementedTradingServer) StreamOrderBook(*OrderBookRequest, Trading_StreamOrderBookServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOrderBook not implemented")
+}
+func (UnimplementedTradingServer) StreamOrders(*emptypb.Empty, Trading_StreamOrdersServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamOrders not implemented")
+}
+func (UnimplementedTradingServer) Deposit(context.Context, *CashDeposit) (*Balance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (UnimplementedTradingServer) GetBalance(context.Context, *AccountRequest) (*Balance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedTradingServer) ListPositions(context.Context, *AccountRequest) (*PositionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPositions not implemented")
+}
+func (UnimplementedTradingServer) GetPnL(context.Context, *AccountRequest) (*PnL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPnL not implemented")
+}
+func (UnimplementedTradingServer) GetMarketSession(context.Context, *emptypb.Empty) (*MarketSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketSession not implemented")
+}
+func (UnimplementedTradingServer) StreamMarketSessions(*emptypb.Empty, Trading_StreamMarketSessionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMarketSessions not implemented")
+}
+func (UnimplementedTradingServer) mustEmbedUnimplementedTradingServer() {}
+
+// UnsafeTradingServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TradingServer will
+// result in compilation errors.
+type UnsafeTradingServer interface {
+	mustEmbedUnimplementedTradingServer()
+}
+
+func RegisterTradingServer(s grpc.ServiceRegistrar, srv TradingServer) {
+	s.RegisterService(&Trading_ServiceDesc, srv)
+}
+
+func _Trading_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trading.Trading/PlaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServer).PlaceOrder(ctx, req.(*NewOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trading_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trading.Trading/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServer).CancelOrder(ctx, req.(*OrderId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trading_ReplaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderReplacement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServer).ReplaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trading.Trading/ReplaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServer).ReplaceOrder(ctx, req.(*OrderReplacement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trading_StreamOrderBook_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(OrderBookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TradingServer).StreamOrderBook(m, &tradingStreamOrderBookServer{stream})
+}
+
+type Trading_StreamOrderBookServer interface {
+	Send(*OrderBook) error
+	grpc.ServerStream
+}
+
+type tradingStreamOrderBookServer struct {
+	grpc.ServerStream
+}
+
+func (x *tradingStreamOrderBookServer) Send(m *OrderBook) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Trading_StreamOrders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TradingServer).StreamOrders(m, &tradingStreamOrdersServer{stream})
+}
+
+type Trading_StreamOrdersServer interface {
+	Send(*Order) error
+	grpc.ServerStream
+}
+
+type tradingStreamOrdersServer struct {
+	grpc.ServerStream
+}
+
+func (x *tradingStreamOrdersServer) Send(m *Order) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Trading_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CashDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trading.Trading/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServer).Deposit(ctx, req.(*CashDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trading_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trading.Trading/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServer).GetBalance(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trading_ListPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServer).ListPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trading.Trading/ListPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServer).ListPositions(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trading_GetPnL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServer).GetPnL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trading.Trading/GetPnL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServer).GetPnL(ctx, req.(*AccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trading_GetMarketSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradingServer).GetMarketSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/trading.Trading/GetMarketSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradingServer).GetMarketSession(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Trading_StreamMarketSessions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TradingServer).StreamMarketSessions(m, &tradingStreamMarketSessionsServer{stream})
+}
+
+type Trading_StreamMarketSessionsServer interface {
+	Send(*MarketSession) error
+	grpc.ServerStream
+}
+
+type tradingStreamMarketSessionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *tradingStreamMarketSessionsServer) Send(m *MarketSession) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Trading_ServiceDesc is the grpc.ServiceDesc for Trading service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Trading_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "trading.Trading",
+	HandlerType: (*TradingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _Trading_PlaceOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Trading_CancelOrder_Handler,
+		},
+		{
+			MethodName: "ReplaceOrder",
+			Handler:    _Trading_ReplaceOrder_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Trading_Deposit_Handler,
+		},
+		{
+			MethodName: "GetBalance",
+			Handler:    _Trading_GetBalance_Handler,
+		},
+		{
+			MethodName: "ListPositions",
+			Handler:    _Trading_ListPositions_Handler,
+		},
+		{
+			MethodName: "GetPnL",
+			Handler:    _Trading_GetPnL_Handler,
+		},
+		{
+			MethodName: "GetMarketSession",
+			Handler:    _Trading_GetMarketSession_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamOrderBook",
+			Handler:       _Trading_StreamOrderBook_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamOrders",
+			Handler:       _Trading_StreamOrders_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamMarketSessions",
+			Handler:       _Trading_StreamMarketSessions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "trading.proto",
+}
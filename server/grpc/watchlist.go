@@ -0,0 +1,340 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const maxWatchlistCodes = 100
+
+func (s *Stocks) CreateWatchlist(ctx context.Context, newWatchlist *NewWatchlist) (*Watchlist, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/CreateWatchlist")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	owner := principalFromContext(ctx)
+	if owner.Anonymous() {
+		loggerFromContext(ctx).Errorf("CreateWatchlist: anonymous principal")
+		return nil, status.Error(codes.Unauthenticated, "an account is required to create a watchlist")
+	}
+
+	name, list, err := validateWatchlist(newWatchlist.Name, newWatchlist.Codes)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CreateWatchlist")
+		return nil, err
+	}
+
+	watchlist, err := insertWatchlist(ctx, db, owner.UserId, name, list)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("CreateWatchlist")
+		return nil, err
+	}
+
+	return watchlist, nil
+}
+
+func (s *Stocks) ListWatchlists(ctx context.Context, _ *emptypb.Empty) (*WatchlistList, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/ListWatchlists")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	owner := principalFromContext(ctx)
+	if owner.Anonymous() {
+		loggerFromContext(ctx).Errorf("ListWatchlists: anonymous principal")
+		return nil, status.Error(codes.Unauthenticated, "an account is required to list watchlists")
+	}
+
+	list, err := selectWatchlists(ctx, db, "w.user_id = $1", owner.UserId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("ListWatchlists")
+		return nil, err
+	}
+
+	return &WatchlistList{
+		WatchlistList: list,
+	}, nil
+}
+
+func (s *Stocks) GetWatchlist(ctx context.Context, watchlistId *WatchlistId) (*Watchlist, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/GetWatchlist")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	watchlist, err := selectWatchlist(ctx, db, principalFromContext(ctx).UserId, watchlistId.Id)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("GetWatchlist")
+		return nil, err
+	}
+
+	return watchlist, nil
+}
+
+func (s *Stocks) UpdateWatchlist(ctx context.Context, update *WatchlistUpdate) (*Watchlist, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/UpdateWatchlist")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	name, list, err := validateWatchlist(update.Name, update.Codes)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("UpdateWatchlist")
+		return nil, err
+	}
+
+	watchlist, err := updateWatchlist(ctx, db, principalFromContext(ctx).UserId, update.Id, name, list)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("UpdateWatchlist")
+		return nil, err
+	}
+
+	s.watchlists.publish(watchlist)
+
+	return watchlist, nil
+}
+
+func (s *Stocks) DeleteWatchlist(ctx context.Context, watchlistId *WatchlistId) (*emptypb.Empty, error) {
+	tx := sentry.TransactionFromContext(ctx)
+	span := tx.StartChild("/stocks.Stocks/DeleteWatchlist")
+	defer span.Finish()
+
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	result, err := db.ExecContext(ctx,
+		"DELETE FROM watchlist WHERE id = $1 AND user_id = $2",
+		watchlistId.Id, principalFromContext(ctx).UserId)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("DeleteWatchlist")
+		return nil, err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		loggerFromContext(ctx).Errorf("DeleteWatchlist: watchlist '%d' is not exists", watchlistId.Id)
+		return nil, status.Errorf(codes.NotFound, "watchlist '%d' is not exists", watchlistId.Id)
+	}
+
+	s.watchlists.publish(&Watchlist{Id: watchlistId.Id})
+
+	return &emptypb.Empty{}, nil
+}
+
+// StreamWatchlist sends the latest quote of every code in the list and then
+// every new quote of them. Updating the list changes the codes of open
+// streams, and deleting it ends them.
+func (s *Stocks) StreamWatchlist(watchlistId *WatchlistId, stream Stocks_StreamWatchlistServer) error {
+	ctx := stream.Context()
+	db := ctx.Value(DBSession).(*sql.DB)
+
+	watchlist, err := selectWatchlist(ctx, db, principalFromContext(ctx).UserId, watchlistId.Id)
+	if err != nil {
+		loggerFromContext(ctx).WithError(err).Error("StreamWatchlist")
+		return err
+	}
+
+	changes := s.watchlists.subscribe()
+	defer s.watchlists.unsubscribe(changes)
+
+	quotes := s.feed.quotes.subscribe()
+	defer s.feed.quotes.unsubscribe(quotes)
+
+	var snapshot []*Quote
+	if len(watchlist.Codes) != 0 {
+		snapshot = s.feed.Snapshot(watchlist.Codes)
+	}
+	for _, q := range snapshot {
+		if err := stream.Send(q); err != nil {
+			loggerFromContext(ctx).WithError(err).Error("StreamWatchlist")
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case changed := <-changes:
+			if changed.Id != watchlist.Id {
+				continue
+			}
+			if changed.Name == "" {
+				return status.Errorf(codes.NotFound, "watchlist '%d' is deleted", watchlist.Id)
+			}
+			watchlist = changed
+		case q := <-quotes:
+			if len(watchlist.Codes) == 0 || !containsCode(watchlist.Codes, q.Code) {
+				continue
+			}
+			if err := stream.Send(q); err != nil {
+				loggerFromContext(ctx).WithError(err).Error("StreamWatchlist")
+				return err
+			}
+		}
+	}
+}
+
+func validateWatchlist(name string, list []string) (string, []string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", nil, status.Error(codes.InvalidArgument, "'name' is empty")
+	}
+
+	upper := make([]string, 0, len(list))
+	for _, c := range list {
+		c = strings.ToUpper(strings.TrimSpace(c))
+		if !containsCode(upper, c) {
+			upper = append(upper, c)
+		}
+	}
+	if len(upper) > maxWatchlistCodes {
+		return "", nil, status.Errorf(codes.InvalidArgument, "a watchlist can hold up to %d codes", maxWatchlistCodes)
+	}
+
+	return name, upper, nil
+}
+
+func selectWatchlist(ctx context.Context, db *sql.DB, userId string, id int64) (*Watchlist, error) {
+	list, err := selectWatchlists(ctx, db, "w.user_id = $1 AND w.id = $2", userId, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, status.Errorf(codes.NotFound, "watchlist '%d' is not exists", id)
+	}
+	return list[0], nil
+}
+
+func selectWatchlists(ctx context.Context, db *sql.DB, cond string, args ...interface{}) ([]*Watchlist, error) {
+	rows, err := db.QueryContext(ctx, `
+SELECT w.id, w.name, w.created_at,
+       array_remove(array_agg(s.code ORDER BY i.position), NULL)
+  FROM watchlist w
+  LEFT JOIN watchlist_item i ON i.watchlist_id = w.id
+  LEFT JOIN stocks s ON s.id = i.stock_id
+ WHERE `+cond+`
+ GROUP BY w.id
+ ORDER BY w.id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Watchlist
+
+	for rows.Next() {
+		var createdAt time.Time
+		watchlist := &Watchlist{}
+		if err := rows.Scan(&watchlist.Id, &watchlist.Name, &createdAt, pq.Array(&watchlist.Codes)); err != nil {
+			return nil, err
+		}
+		watchlist.CreatedAt = timestamppb.New(createdAt)
+		list = append(list, watchlist)
+	}
+
+	return list, rows.Err()
+}
+
+func insertWatchlist(ctx context.Context, db *sql.DB, userId, name string, list []string) (*Watchlist, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var createdAt time.Time
+	watchlist := &Watchlist{Name: name, Codes: list}
+
+	err = tx.QueryRowContext(ctx,
+		"INSERT INTO watchlist(user_id, name) VALUES ($1, $2) RETURNING id, created_at",
+		userId, name).Scan(&watchlist.Id, &createdAt)
+	if err, ok := err.(*pq.Error); ok && err.Code == "23505" {
+		return nil, status.Errorf(codes.AlreadyExists, "watchlist '%s' already exists", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	watchlist.CreatedAt = timestamppb.New(createdAt)
+
+	if err := replaceWatchlistItems(ctx, tx, watchlist.Id, list); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return watchlist, nil
+}
+
+func updateWatchlist(ctx context.Context, db *sql.DB, userId string, id int64, name string, list []string) (*Watchlist, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var createdAt time.Time
+	watchlist := &Watchlist{Id: id, Name: name, Codes: list}
+
+	err = tx.QueryRowContext(ctx,
+		"UPDATE watchlist SET name = $1 WHERE id = $2 AND user_id = $3 RETURNING created_at",
+		name, id, userId).Scan(&createdAt)
+	if err == sql.ErrNoRows {
+		return nil, status.Errorf(codes.NotFound, "watchlist '%d' is not exists", id)
+	}
+	if err, ok := err.(*pq.Error); ok && err.Code == "23505" {
+		return nil, status.Errorf(codes.AlreadyExists, "watchlist '%s' already exists", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	watchlist.CreatedAt = timestamppb.New(createdAt)
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM watchlist_item WHERE watchlist_id = $1", id); err != nil {
+		return nil, err
+	}
+	if err := replaceWatchlistItems(ctx, tx, id, list); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return watchlist, nil
+}
+
+func replaceWatchlistItems(ctx context.Context, tx *sql.Tx, id int64, list []string) error {
+	if len(list) == 0 {
+		return nil
+	}
+
+	result, err := tx.ExecContext(ctx, `
+INSERT INTO watchlist_item(watchlist_id, stock_id, position)
+SELECT $1, s.id, c.position
+  FROM unnest($2::varchar[]) WITH ORDINALITY AS c(code, position)
+  JOIN stocks s ON s.code = c.code`,
+		id, pq.Array(list))
+	if err != nil {
+		return err
+	}
+
+	if n, _ := result.RowsAffected(); int(n) != len(list) {
+		return status.Error(codes.InvalidArgument, "'codes' has unknown stocks")
+	}
+	return nil
+}
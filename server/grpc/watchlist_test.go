@@ -0,0 +1,176 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	// external packages
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateWatchlist(t *testing.T) {
+	tooMany := make([]string, maxWatchlistCodes+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("%03d", i)
+	}
+
+	tests := []struct {
+		name     string
+		in       string
+		codes    []string
+		wantName string
+		want     []string
+		code     codes.Code
+	}{
+		{"trimmed", "  mine ", []string{" aaa", "BBB "}, "mine", []string{"AAA", "BBB"}, codes.OK},
+		{"duplicates", "mine", []string{"aaa", "AAA", "bbb"}, "mine", []string{"AAA", "BBB"}, codes.OK},
+		{"no codes", "mine", nil, "mine", []string{}, codes.OK},
+		{"empty name", "  ", []string{"AAA"}, "", nil, codes.InvalidArgument},
+		{"too many codes", "mine", tooMany, "", nil, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, list, err := validateWatchlist(tt.in, tt.codes)
+			if status.Code(err) != tt.code {
+				t.Fatalf("err = %v, want %v", err, tt.code)
+			}
+			if name != tt.wantName || !reflect.DeepEqual(list, tt.want) {
+				t.Fatalf("validateWatchlist = %q, %q, want %q, %q", name, list, tt.wantName, tt.want)
+			}
+		})
+	}
+}
+
+// testWatchlistStream is the server side of a StreamWatchlist call.
+type testWatchlistStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *Quote
+}
+
+func (s *testWatchlistStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testWatchlistStream) Send(q *Quote) error {
+	s.sent <- q
+	return nil
+}
+
+// newTestWatchlistContexts returns the contexts of two users that own no
+// watchlist yet and removes theirs when the test ends.
+func newTestWatchlistContexts(t *testing.T) (context.Context, context.Context) {
+	t.Helper()
+	db := openTestDB(t)
+
+	tx := sentry.StartTransaction(context.Background(), "test")
+	t.Cleanup(tx.Finish)
+	ctx := context.WithValue(tx.Context(), DBSession, db)
+
+	var users []context.Context
+	for _, name := range []string{"alice", "bob"} {
+		userId := fmt.Sprintf("%s-%s-%d", t.Name(), name, time.Now().UnixNano())
+		t.Cleanup(func() { db.Exec("DELETE FROM watchlist WHERE user_id = $1", userId) })
+		users = append(users, context.WithValue(ctx, principalKey, &Principal{UserId: userId}))
+	}
+	return users[0], users[1]
+}
+
+func TestWatchlistUnknownCodes(t *testing.T) {
+	alice, _ := newTestWatchlistContexts(t)
+	db := alice.Value(DBSession).(*sql.DB)
+	stocks := []*Stock{insertTestStock(t, db, 10), insertTestStock(t, db, 10)}
+	s := &Stocks{watchlists: newBroker[*Watchlist]()}
+
+	_, err := s.CreateWatchlist(alice, &NewWatchlist{Name: "mine", Codes: []string{stocks[0].Code, "???"}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("CreateWatchlist = %v, want InvalidArgument", err)
+	}
+	if list, err := s.ListWatchlists(alice, nil); err != nil || len(list.WatchlistList) != 0 {
+		t.Fatalf("ListWatchlists = %v, %v, want the rejected list left out", list, err)
+	}
+
+	watchlist, err := s.CreateWatchlist(alice, &NewWatchlist{Name: "mine", Codes: []string{stocks[1].Code, strings.ToLower(stocks[0].Code)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = s.UpdateWatchlist(alice, &WatchlistUpdate{Id: watchlist.Id, Name: "renamed", Codes: []string{"???"}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("UpdateWatchlist = %v, want InvalidArgument", err)
+	}
+
+	got, err := s.GetWatchlist(alice, &WatchlistId{Id: watchlist.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{stocks[1].Code, stocks[0].Code}; got.Name != "mine" || !reflect.DeepEqual(got.Codes, want) {
+		t.Fatalf("watchlist = %q %v, want it unchanged as mine %v", got.Name, got.Codes, want)
+	}
+}
+
+func TestStreamWatchlistOwnership(t *testing.T) {
+	alice, bob := newTestWatchlistContexts(t)
+	db := alice.Value(DBSession).(*sql.DB)
+	stock := insertTestStock(t, db, 10)
+
+	feed := NewPriceFeed(nil, PriceFeedConfig{}, nil)
+	feed.stocks = []*simStock{
+		{code: stock.Code, last: &Quote{Code: stock.Code, Last: 100}},
+		{code: "AAA", last: &Quote{Code: "AAA", Last: 100}},
+	}
+	s := &Stocks{feed: feed, watchlists: newBroker[*Watchlist]()}
+
+	watchlist, err := s.CreateWatchlist(alice, &NewWatchlist{Name: "mine", Codes: []string{stock.Code}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stream := func(ctx context.Context) (*testWatchlistStream, chan error) {
+		ss := &testWatchlistStream{ctx: ctx, sent: make(chan *Quote, 8)}
+		done := make(chan error, 1)
+		go func() { done <- s.StreamWatchlist(&WatchlistId{Id: watchlist.Id}, ss) }()
+		return ss, done
+	}
+
+	if _, done := stream(bob); status.Code(<-done) != codes.NotFound {
+		t.Fatal("another user streams the watchlist")
+	}
+	if _, err := s.DeleteWatchlist(bob, &WatchlistId{Id: watchlist.Id}); status.Code(err) != codes.NotFound {
+		t.Fatalf("DeleteWatchlist by another user = %v, want NotFound", err)
+	}
+
+	owner, done := stream(alice)
+	select {
+	case q := <-owner.sent:
+		if q.Code != stock.Code {
+			t.Fatalf("quote of %s, want only the codes of the list", q.Code)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no snapshot sent to the owner")
+	}
+
+	if _, err := s.DeleteWatchlist(alice, &WatchlistId{Id: watchlist.Id}); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-done:
+		if status.Code(err) != codes.NotFound {
+			t.Fatalf("StreamWatchlist = %v, want NotFound once deleted", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the stream outlived its watchlist")
+	}
+	if n := len(owner.sent); n != 0 {
+		t.Fatalf("%d more quotes sent, want only the snapshot of the list", n)
+	}
+}
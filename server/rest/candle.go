@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	// external packages
+	"github.com/valyala/fasthttp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	finpc "github.com/ghilbut/finpc/grpc"
+)
+
+type candle struct {
+	Code   string    `json:"code"`
+	Period string    `json:"interval"`
+	Start  time.Time `json:"start"`
+	Open   float64   `json:"open"`
+	High   float64   `json:"high"`
+	Low    float64   `json:"low"`
+	Close  float64   `json:"close"`
+	Volume int64     `json:"volume"`
+}
+
+// getCandles serves /candles?code=IMP&interval=1m&from=...&to=...&format=csv
+// where from and to are RFC 3339 times.
+func (o *Rest) getCandles(ctx *fasthttp.RequestCtx) {
+	args := ctx.QueryArgs()
+
+	from, err := parseTime(args.Peek("from"))
+	if err != nil {
+		ctx.Error("invalid 'from'", fasthttp.StatusBadRequest)
+		return
+	}
+	to, err := parseTime(args.Peek("to"))
+	if err != nil {
+		ctx.Error("invalid 'to'", fasthttp.StatusBadRequest)
+		return
+	}
+
+	list, err := o.candles.Candles(ctx, string(args.Peek("code")), string(args.Peek("interval")), from, to)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			ctx.Error(status.Convert(err).Message(), fasthttp.StatusBadRequest)
+		default:
+			ctx.Error(err.Error(), fasthttp.StatusInternalServerError)
+		}
+		return
+	}
+
+	format := string(args.Peek("format"))
+	if format == "" && strings.Contains(string(ctx.Request.Header.Peek(fasthttp.HeaderAccept)), "text/csv") {
+		format = "csv"
+	}
+
+	if format == "csv" {
+		writeCandlesCSV(ctx, list)
+		return
+	}
+
+	candles := make([]candle, 0, len(list))
+	for _, c := range list {
+		candles = append(candles, candle{
+			Code:   c.Code,
+			Period: c.Interval,
+			Start:  c.Start.AsTime(),
+			Open:   c.Open,
+			High:   c.High,
+			Low:    c.Low,
+			Close:  c.Close,
+			Volume: c.Volume,
+		})
+	}
+
+	ctx.SetContentType("application/json")
+	if err := json.NewEncoder(ctx).Encode(candles); err != nil {
+		ctx.Error(err.Error(), fasthttp.StatusInternalServerError)
+	}
+}
+
+func writeCandlesCSV(ctx *fasthttp.RequestCtx, list []*finpc.Candle) {
+	ctx.SetContentType("text/csv")
+
+	w := csv.NewWriter(ctx)
+	w.Write([]string{"code", "interval", "start", "open", "high", "low", "close", "volume"})
+	for _, c := range list {
+		w.Write([]string{
+			c.Code,
+			c.Interval,
+			c.Start.AsTime().Format(time.RFC3339),
+			strconv.FormatFloat(c.Open, 'f', -1, 64),
+			strconv.FormatFloat(c.High, 'f', -1, 64),
+			strconv.FormatFloat(c.Low, 'f', -1, 64),
+			strconv.FormatFloat(c.Close, 'f', -1, 64),
+			strconv.FormatInt(c.Volume, 10),
+		})
+	}
+	w.Flush()
+
+	if err := w.Error(); err != nil {
+		ctx.Error(err.Error(), fasthttp.StatusInternalServerError)
+	}
+}
+
+func parseTime(value []byte) (*timestamppb.Timestamp, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, string(value))
+	if err != nil {
+		return nil, err
+	}
+	return timestamppb.New(t), nil
+}
@@ -4,18 +4,29 @@ import (
 	"fmt"
 	"github.com/getsentry/sentry-go"
 	"github.com/valyala/fasthttp"
+
+	finpc "github.com/ghilbut/finpc/grpc"
 )
 
 type Rest struct {
+	candles  *finpc.CandleAggregator
+	handlers map[string]fasthttp.RequestHandler
 }
 
-func NewRestServer() *Rest {
-	return &Rest{}
+func NewRestServer(candles *finpc.CandleAggregator) *Rest {
+	o := &Rest{
+		candles: candles,
+	}
+	o.handlers = map[string]fasthttp.RequestHandler{
+		"/healthz": allowMethods(healthz, fasthttp.MethodGet),
+		"/candles": allowMethods(o.getCandles, fasthttp.MethodGet),
+	}
+	return o
 }
 
 func (o *Rest) Handler(ctx *fasthttp.RequestCtx) {
 	path := string(ctx.Path())
-	if handler, ok := handlers[path]; ok {
+	if handler, ok := o.handlers[path]; ok {
 		handler(ctx)
 		return
 	}
@@ -25,10 +36,6 @@ func (o *Rest) Handler(ctx *fasthttp.RequestCtx) {
 	ctx.Error(err, fasthttp.StatusNotFound)
 }
 
-var handlers = map[string]fasthttp.RequestHandler{
-	"/healthz": allowMethods(healthz, fasthttp.MethodGet),
-}
-
 func healthz(ctx *fasthttp.RequestCtx) {
 	ctx.SetStatusCode(fasthttp.StatusOK)
 	ctx.SetBody([]byte("OK"))
@@ -2,7 +2,19 @@ package rest
 
 import (
 	"fmt"
+	"time"
+
+	// external packages
+	sentryfasthttp "github.com/getsentry/sentry-go/fasthttp"
+	log "github.com/sirupsen/logrus"
 	"github.com/valyala/fasthttp"
+
+	finpc "github.com/ghilbut/finpc/grpc"
+)
+
+const (
+	requestIdHeader string = "X-Request-Id"
+	requestIdKey    string = "requestId"
 )
 
 func allowMethods(next fasthttp.RequestHandler, allows ...string) fasthttp.RequestHandler {
@@ -26,3 +38,48 @@ func contains(method string, allows []string) bool {
 	}
 	return false
 }
+
+// AccessLog writes a line for every request once next has served it.
+func AccessLog(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		start := time.Now()
+
+		next(ctx)
+
+		entry := log.WithFields(log.Fields{
+			"request_id": ctx.UserValue(requestIdKey),
+			"method":     string(ctx.Method()),
+			"path":       string(ctx.Path()),
+			"status":     ctx.Response.StatusCode(),
+			"bytes":      len(ctx.Response.Body()),
+			"remote_ip":  ctx.RemoteIP().String(),
+			"latency_ms": time.Since(start).Milliseconds(),
+		})
+		if ctx.Response.StatusCode() >= fasthttp.StatusInternalServerError {
+			entry.Error("REST access")
+		} else {
+			entry.Info("REST access")
+		}
+	}
+}
+
+// RequestId accepts the X-Request-Id of the caller, or generates one, and
+// echoes it in the response and the Sentry scope of the request.
+func RequestId(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	return func(ctx *fasthttp.RequestCtx) {
+		requestId := string(ctx.Request.Header.Peek(requestIdHeader))
+		if !finpc.ValidRequestId(requestId) {
+			requestId = finpc.NewRequestId()
+		}
+
+		ctx.SetUserValue(requestIdKey, requestId)
+		if hub := sentryfasthttp.GetHubFromContext(ctx); hub != nil {
+			hub.Scope().SetTag("request_id", requestId)
+		}
+
+		next(ctx)
+
+		// set after next, since ctx.Error resets the response headers
+		ctx.Response.Header.Set(requestIdHeader, requestId)
+	}
+}
@@ -99,9 +99,17 @@ resource aws_ecs_task_definition server {
           {
             "name": "SENTRY_ENVIRONMET",
             "value": "ecs"
+          },
+          {
+            "name": "TRUSTED_PROXIES",
+            "value": "${var.cidr_block}"
           }
         ],
         "secrets": [
+          {
+            "name": "AUTH_SECRET",
+            "valueFrom": "${aws_secretsmanager_secret.auth_secret.arn}"
+          },
           {
             "name": "PG_PASSWORD",
             "valueFrom": "${aws_secretsmanager_secret.postgres_password.arn}"
@@ -185,6 +193,21 @@ resource aws_security_group server {
 ##  AWS Secrets Manager
 ##
 
+resource aws_secretsmanager_secret auth_secret {
+  name = "${var.project}-auth-secret"
+  recovery_window_in_days = 0
+}
+
+resource aws_secretsmanager_secret_version auth_secret {
+  secret_id     = aws_secretsmanager_secret.auth_secret.id
+  secret_string = random_password.auth_secret.result
+}
+
+resource random_password auth_secret {
+  length  = 32
+  special = false
+}
+
 resource aws_secretsmanager_secret postgres_password {
   name = "${var.project}-postgres-password"
   recovery_window_in_days = 0